@@ -0,0 +1,165 @@
+// Package assets downloads images hosted by the platform, such as user
+// avatars, team logos and badge icons, with an optional on-disk cache.
+package assets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// DefaultBaseURL is the origin relative asset paths are resolved against.
+const DefaultBaseURL = "https://labs.hackthebox.com"
+
+// ErrNotImage is returned when the server answers with a content type other
+// than image/*.
+var ErrNotImage = errors.New("response is not an image")
+
+// Doer sends HTTP requests. *gohtb.Client implements it, adding credentials
+// only for the API host.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type options struct {
+	cache   *Cache
+	baseURL string
+}
+
+// Option configures a Fetch call.
+type Option func(*options)
+
+// WithCache serves the image from cache when present and stores it there
+// after a successful download.
+func WithCache(cache *Cache) Option {
+	return func(o *options) {
+		o.cache = cache
+	}
+}
+
+// WithBaseURL sets the origin relative paths are resolved against.
+// Defaults to DefaultBaseURL.
+func WithBaseURL(base string) Option {
+	return func(o *options) {
+		o.baseURL = base
+	}
+}
+
+// Resolve turns an asset reference as returned by the API into an absolute
+// URL. Absolute http and https URLs are returned unchanged; anything else is
+// treated as a path on base, or on DefaultBaseURL if base is empty.
+//
+// Example:
+//
+//	u, err := assets.Resolve("", profile.Data.Avatar)
+//	// https://labs.hackthebox.com/storage/avatars/abc.png
+func Resolve(base, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", errors.New("empty asset URL")
+	}
+	if base == "" {
+		base = DefaultBaseURL
+	}
+
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("parse asset URL: %w", err)
+	}
+	if parsed.IsAbs() {
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return "", fmt.Errorf("unsupported asset URL scheme %q", parsed.Scheme)
+		}
+		return parsed.String(), nil
+	}
+
+	root, err := url.Parse(strings.TrimRight(base, "/") + "/")
+	if err != nil {
+		return "", fmt.Errorf("parse base URL: %w", err)
+	}
+	return root.ResolveReference(&url.URL{
+		Path:     strings.TrimLeft(parsed.Path, "/"),
+		RawQuery: parsed.RawQuery,
+	}).String(), nil
+}
+
+// Fetch downloads the image at ref and writes it to w. ref may be an
+// absolute URL or a path as found in response structs; see Resolve.
+//
+// The request goes through client, so passing a *gohtb.Client shares its
+// rate limiting and retries and sends the bearer token only to the API host.
+// Responses that are not image/* fail with ErrNotImage and nothing is
+// written to w. Cache timestamps are taken from the client's clock when it
+// has one, as a *gohtb.Client does, and from the wall clock otherwise.
+//
+// Example:
+//
+//	cache, err := assets.NewCache(filepath.Join(os.TempDir(), "htb-avatars"), 50<<20)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	var buf bytes.Buffer
+//	err = assets.Fetch(ctx, client, user.Data.Avatar, &buf, assets.WithCache(cache))
+func Fetch(ctx context.Context, client Doer, ref string, w io.Writer, opts ...Option) error {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	target, err := Resolve(o.baseURL, ref)
+	if err != nil {
+		return err
+	}
+	clk := clock.From(client)
+
+	if o.cache != nil {
+		hit, err := o.cache.copyTo(clk, target, w)
+		if hit || err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &errutil.APIError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("fetch %s: %s", target, http.StatusText(resp.StatusCode)),
+		}
+	}
+	if err := checkImage(resp.Header.Get("Content-Type")); err != nil {
+		return err
+	}
+
+	if o.cache != nil {
+		return o.cache.store(clk, target, resp.Body, w)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func checkImage(contentType string) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "image/") {
+		return fmt.Errorf("%w: content type %q", ErrNotImage, contentType)
+	}
+	return nil
+}
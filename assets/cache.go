@@ -0,0 +1,177 @@
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+const cacheExt = ".img"
+
+// Cache stores downloaded images in a directory, one file per URL named by
+// the URL's SHA-256. When the directory grows beyond MaxBytes the least
+// recently used files are removed. An image larger than MaxBytes on its own
+// is still fetched but never cached. A Cache is safe for concurrent use
+// within one process.
+type Cache struct {
+	dir      string
+	maxBytes int64
+	mu       sync.Mutex
+}
+
+// NewCache creates dir if needed and returns a cache rooted there.
+// A maxBytes of zero or less disables eviction.
+func NewCache(dir string, maxBytes int64) (*Cache, error) {
+	if dir == "" {
+		return nil, errors.New("cache directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir, maxBytes: maxBytes}, nil
+}
+
+func (c *Cache) path(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+cacheExt)
+}
+
+// copyTo writes the cached image for rawURL to w, reporting whether it was
+// present. A hit sets the file's modification time to clk's now for
+// eviction. The
+// lock is only held to open the file, so a slow w does not hold up other
+// fetches.
+func (c *Cache) copyTo(clk clock.Clock, rawURL string, w io.Writer) (bool, error) {
+	c.mu.Lock()
+	name := c.path(rawURL)
+	f, err := os.Open(name)
+	if err == nil {
+		now := clk.Now()
+		_ = os.Chtimes(name, now, now)
+	}
+	c.mu.Unlock()
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return true, err
+}
+
+// store streams body to w and saves a copy under rawURL. The copy is
+// downloaded to a temporary file without holding the lock, so a slow
+// download does not hold up other fetches, and is only renamed into place
+// once complete. An image larger than maxBytes, or one the cache fails to
+// write, is still streamed to w but not cached; only errors reading body or
+// writing w are returned. The stored file's modification time is set from
+// clk, like a hit in copyTo.
+func (c *Cache) store(clk clock.Clock, rawURL string, body io.Reader, w io.Writer) error {
+	tmp, err := os.CreateTemp(c.dir, "download-*")
+	if err != nil {
+		_, err = io.Copy(w, body)
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	s := &spool{f: tmp, limit: c.maxBytes}
+	if _, err := io.Copy(io.MultiWriter(w, s), body); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil || !s.ok() {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := c.path(rawURL)
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return nil
+	}
+	now := clk.Now()
+	_ = os.Chtimes(name, now, now)
+	_ = c.evict()
+	return nil
+}
+
+// spool copies what is written to it into f until a write fails or more
+// than limit bytes arrive. It never fails itself, so a problem with the
+// cache cannot interrupt the stream to the caller.
+type spool struct {
+	f     *os.File
+	limit int64
+	n     int64
+	err   error
+}
+
+func (s *spool) Write(p []byte) (int, error) {
+	s.n += int64(len(p))
+	if s.ok() {
+		_, s.err = s.f.Write(p)
+	}
+	return len(p), nil
+}
+
+// ok reports whether f holds everything written so far.
+func (s *spool) ok() bool {
+	return s.err == nil && (s.limit <= 0 || s.n <= s.limit)
+}
+
+// evict removes the least recently used entries until the cache fits within
+// maxBytes.
+func (c *Cache) evict() error {
+	if c.maxBytes <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	type entry struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var files []entry
+	var total int64
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), cacheExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, entry{e.Name(), info.Size(), info.ModTime()})
+		total += info.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	for _, f := range files {
+		if total <= c.maxBytes {
+			break
+		}
+		if err := os.Remove(filepath.Join(c.dir, f.name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		total -= f.size
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	v4client "github.com/gubarz/gohtb/httpclient/v4"
 	v5client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/logging"
 	"github.com/gubarz/gohtb/services/badges"
 	"github.com/gubarz/gohtb/services/challenges"
@@ -43,6 +44,7 @@ type Client struct {
 	v4api       v4client.ClientInterface
 	v5api       v5client.ClientInterface
 	httpClient  *http.Client
+	transport   http.RoundTripper
 	htbToken    string
 	logger      Logger
 	rateLimiter *RateLimiter
@@ -51,6 +53,26 @@ type Client struct {
 	timeout     time.Duration
 	debug       bool
 	retryConfig RetryConfig
+	inflight    *inflightTracker
+	events      *eventBus
+	identity    string
+	statusURL   string
+	rateBurst   int
+	rateRefill  time.Duration
+	onWarning   func(Warning)
+	// dedupRequests enables WithRequestDeduplication.
+	dedupRequests bool
+	stats         *clientStats
+
+	// baseHTTPClient is the client before shutdown tracking is added, and
+	// apiTransport its rate limiting transport when the default one is used.
+	// Both are kept so WithToken can build on them.
+	baseHTTPClient *http.Client
+	apiTransport   *APITransport
+
+	instanceLock instanceLock
+	clock        Clock
+	rawFlags     bool
 
 	// Services
 
@@ -135,6 +157,10 @@ func New(token string, options ...Option) (*Client, error) {
 		logger:    logging.NoopLogger{},
 		userAgent: defaultUserAgent,
 		timeout:   60 * time.Second,
+		inflight:  newInflightTracker(),
+		events:    newEventBus(),
+		stats:     newClientStats(),
+		clock:     clock.Real{},
 		retryConfig: RetryConfig{
 			MaxRetries:  4,
 			RetryPolicy: &DefaultRetryPolicy{},
@@ -149,17 +175,21 @@ func New(token string, options ...Option) (*Client, error) {
 	if c.httpClient != nil {
 		finalHTTPClient = c.httpClient
 		c.logger.Info("Using custom HTTP client provided via WithHTTPClient option. Note: Internal rate limiting and retry logic might be bypassed unless the custom client's transport is configured accordingly.")
-		c.rateLimiter = NewRateLimiter(context.Background(), c.logger)
+		c.rateLimiter = c.newRateLimiter()
 
 	} else {
 		c.logger.Debug("Setting up default internal HTTP client with rate limiting and retries.")
-		c.rateLimiter = NewRateLimiter(context.Background(), c.logger)
+		c.rateLimiter = c.newRateLimiter()
+		c.rateLimiter.events = c.events
 		apiTransport := NewAPITransport(
-			http.DefaultTransport,
+			c.transport,
 			c.rateLimiter,
 			c.retryConfig,
 			c.logger,
 		)
+		apiTransport.clock = c.clock
+		apiTransport.events = c.events
+		c.apiTransport = apiTransport
 
 		finalHTTPClient = &http.Client{
 			Timeout:   c.timeout,
@@ -167,31 +197,56 @@ func New(token string, options ...Option) (*Client, error) {
 		}
 		c.httpClient = finalHTTPClient
 	}
+	c.baseHTTPClient = finalHTTPClient
+	if info, err := c.TokenInfo(); err == nil {
+		c.identity = info.Subject
+	}
+
+	if err := c.init(finalHTTPClient); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// init adds request deduplication if enabled, shutdown tracking and the
+// redirect policy to hc, and builds the API clients and services on top of
+// it.
+func (c *Client) init(hc *http.Client) error {
+	if c.dedupRequests {
+		deduped := *hc
+		deduped.Transport = newDedupTransport(hc.Transport)
+		hc = &deduped
+	}
+	hc = wrapHTTPClient(hc, c.inflight)
+	if hc.CheckRedirect == nil {
+		hc.CheckRedirect = stripAuthOnRedirect
+	}
+	c.httpClient = hc
 
 	v4Server := c.server + "/v4"
 	v4, err := v4client.NewClient(
 		v4Server,
-		v4client.WithHTTPClient(finalHTTPClient),
+		v4client.WithHTTPClient(hc),
 		v4client.WithRequestEditorFn(c.addHeaders),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create API client: %w", err)
+		return fmt.Errorf("failed to create API client: %w", err)
 	}
 
 	v5Server := c.server + "/v5"
 	v5, err := v5client.NewClientWithResponses(
 		v5Server,
-		v5client.WithHTTPClient(finalHTTPClient),
+		v5client.WithHTTPClient(hc),
 		v5client.WithRequestEditorFn(c.addHeaders),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("init v5 client: %w", err)
+		return fmt.Errorf("init v5 client: %w", err)
 	}
 
 	c.v4api = v4
 	c.v5api = v5
 	wireServices(c)
-	return c, nil
+	return nil
 }
 
 func (c *Client) addHeaders(ctx context.Context, req *http.Request) error {
@@ -274,12 +329,38 @@ func WithUserAgent(userAgent string) Option {
 // If provided, options like WithTimeout and the default transport setup
 // (including rate limiting and retries via APITransport) will be bypassed.
 // The provided client is used directly. The user is responsible for its configuration.
+// If the client has no CheckRedirect, the default policy that drops the
+// Authorization header on cross-host redirects is installed on the copy the
+// library uses; a CheckRedirect of your own replaces it.
 func WithHTTPClient(customClient *http.Client) Option {
 	return func(c *Client) {
 		c.httpClient = customClient
 	}
 }
 
+// WithTransport sets the round tripper the default client sends requests
+// through once rate limiting and retries have been applied. It defaults to
+// http.DefaultTransport. Unlike WithHTTPClient it keeps the library's own
+// transport in front, so a test transport such as the fault injector in
+// gohtbtest exercises the real retry logic. It has no effect together with
+// WithHTTPClient.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = rt
+	}
+}
+
+// WithRawFlag disables flag normalization. By default flags are trimmed of
+// whitespace and quotes and checked for the expected shape (a 32 character
+// hash for machines, HTB{...} for challenges, PREFIX{...} for fortresses and
+// prolabs) before they are submitted. With this option flags are sent
+// exactly as given.
+func WithRawFlag() Option {
+	return func(c *Client) {
+		c.rawFlags = true
+	}
+}
+
 // ExperimentalClient provides direct access to the generated OpenAPI clients.
 //
 // This is intended as an advanced escape hatch for unsupported endpoints or
@@ -304,7 +385,7 @@ func (e ExperimentalClient) WrapContext(ctx context.Context) context.Context {
 	if e.client == nil || e.client.rateLimiter == nil {
 		return ctx
 	}
-	return e.client.rateLimiter.Wrap(ctx)
+	return withIdentity(e.client.rateLimiter.Wrap(ctx), e.client.identity)
 }
 
 // Experimental returns direct access to the underlying OpenAPI clients.
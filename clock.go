@@ -0,0 +1,44 @@
+package gohtb
+
+import (
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+// Clock is the source of time used for rate limiting, retry backoff and
+// time-based service logic. The default is the wall clock.
+type Clock = clock.Clock
+
+// FakeClock is a manually advanced Clock for tests. Timers fire when the
+// clock is moved past their deadline with Advance or Set.
+type FakeClock = clock.Fake
+
+// NewFakeClock returns a FakeClock set to t.
+//
+// Example:
+//
+//	fake := gohtb.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	client, err := gohtb.New(token, gohtb.WithClock(fake))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fake.Advance(10 * time.Second)
+func NewFakeClock(t time.Time) *FakeClock {
+	return clock.NewFake(t)
+}
+
+// WithClock sets the clock used by the client. Defaults to the wall clock.
+func WithClock(c Clock) Option {
+	return func(cl *Client) {
+		if c != nil {
+			cl.clock = c
+		}
+	}
+}
+
+// Clock returns the clock the client uses, as set by WithClock. Helpers
+// outside the client, such as assets.Fetch, read time from it too.
+func (c *Client) Clock() Clock {
+	return c.clock
+}
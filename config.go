@@ -0,0 +1,152 @@
+package gohtb
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// DefaultTokenEnv is the environment variable NewClientFromConfig reads the
+// token from when Config.TokenEnv is empty.
+const DefaultTokenEnv = "HTB_TOKEN"
+
+// Duration is a time.Duration that is written to JSON as a string such as
+// "30s" or "1m30s". Plain numbers are also accepted and read as
+// nanoseconds.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		var n int64
+		if err := json.Unmarshal(b, &n); err != nil {
+			return fmt.Errorf("duration must be a string like \"30s\" or a number of nanoseconds: %s", b)
+		}
+		*d = Duration(n)
+		return nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// RateLimitConfig is the client-side request budget; see WithRateLimit.
+type RateLimitConfig struct {
+	Burst          int      `json:"burst,omitempty"`
+	RefillInterval Duration `json:"refill_interval,omitempty"`
+}
+
+// RetryConfigJSON is the serializable part of RetryConfig. The retry policy
+// itself is code and is always DefaultRetryPolicy when loaded from a
+// config; pass WithRetry to NewClientFromConfig for a custom one.
+type RetryConfigJSON struct {
+	MaxRetries int `json:"max_retries,omitempty"`
+}
+
+// Config holds client settings that can be stored as JSON and loaded with
+// NewClientFromConfig. Zero values keep the defaults used by New.
+type Config struct {
+	// Token is the API token. It is used only when the environment variable
+	// named by TokenEnv is unset or empty, so a deployment can override the
+	// token in a checked-in config without editing it.
+	Token string `json:"token,omitempty"`
+	// TokenEnv names the environment variable holding the token. Defaults to
+	// DefaultTokenEnv.
+	TokenEnv string `json:"token_env,omitempty"`
+
+	Server    string   `json:"server,omitempty"`
+	UserAgent string   `json:"user_agent,omitempty"`
+	Timeout   Duration `json:"timeout,omitempty"`
+	StatusURL string   `json:"status_url,omitempty"`
+	Debug     bool     `json:"debug,omitempty"`
+
+	RateLimit RateLimitConfig `json:"rate_limit,omitempty"`
+	Retry     RetryConfigJSON `json:"retry,omitempty"`
+
+	RawFlags              bool `json:"raw_flags,omitempty"`
+	SerializedInstanceOps bool `json:"serialized_instance_ops,omitempty"`
+	RequestDeduplication  bool `json:"request_deduplication,omitempty"`
+}
+
+// Options returns the client options equivalent to cfg, leaving out the
+// token.
+func (cfg Config) Options() []Option {
+	var opts []Option
+	if cfg.Server != "" {
+		opts = append(opts, WithServer(cfg.Server))
+	}
+	if cfg.UserAgent != "" {
+		opts = append(opts, WithUserAgent(cfg.UserAgent))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, WithTimeout(time.Duration(cfg.Timeout)))
+	}
+	if cfg.StatusURL != "" {
+		opts = append(opts, WithStatusURL(cfg.StatusURL))
+	}
+	if cfg.Debug {
+		opts = append(opts, WithDebug(true))
+	}
+	if cfg.RateLimit.Burst > 0 || cfg.RateLimit.RefillInterval > 0 {
+		opts = append(opts, WithRateLimit(cfg.RateLimit.Burst, time.Duration(cfg.RateLimit.RefillInterval)))
+	}
+	if cfg.Retry.MaxRetries > 0 {
+		opts = append(opts, WithRetry(RetryConfig{MaxRetries: cfg.Retry.MaxRetries}))
+	}
+	if cfg.RawFlags {
+		opts = append(opts, WithRawFlag())
+	}
+	if cfg.SerializedInstanceOps {
+		opts = append(opts, WithSerializedInstanceOps())
+	}
+	if cfg.RequestDeduplication {
+		opts = append(opts, WithRequestDeduplication())
+	}
+	return opts
+}
+
+// ResolveToken returns the token NewClientFromConfig would use: the value
+// of the TokenEnv environment variable if it is set and non-empty,
+// otherwise Token.
+func (cfg Config) ResolveToken() string {
+	env := cfg.TokenEnv
+	if env == "" {
+		env = DefaultTokenEnv
+	}
+	if token := os.Getenv(env); token != "" {
+		return token
+	}
+	return cfg.Token
+}
+
+// NewClientFromConfig creates a client from cfg. The token is read from the
+// environment variable named by cfg.TokenEnv (HTB_TOKEN by default) and
+// falls back to cfg.Token. options are applied after the config, so they
+// override it and can supply settings that cannot be stored as JSON, such
+// as a logger or a custom retry policy.
+//
+// Example:
+//
+//	raw, err := os.ReadFile("gohtb.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	var cfg gohtb.Config
+//	if err := json.Unmarshal(raw, &cfg); err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := gohtb.NewClientFromConfig(cfg)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func NewClientFromConfig(cfg Config, options ...Option) (*Client, error) {
+	return New(cfg.ResolveToken(), append(cfg.Options(), options...)...)
+}
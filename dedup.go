@@ -0,0 +1,224 @@
+package gohtb
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// WithRequestDeduplication makes concurrent identical GET requests share one
+// HTTP round trip. Requests are identical when their URL, credentials and
+// conditional headers match. Each caller parses its own copy of the
+// response, so results never alias, and every copy but the one of the
+// caller that started the request has ResponseMeta.Deduplicated set. If the shared
+// request fails, every caller gets the error. A caller whose context ends
+// stops waiting without affecting the others; the request itself is
+// cancelled once every caller has given up.
+//
+// Only GET and HEAD requests without a body are shared; anything that can
+// change state always gets its own round trip. A request that is alone when
+// its response arrives is passed through unbuffered, so deduplication adds
+// no cost to calls that do not overlap.
+//
+// Example:
+//
+//	client, err := gohtb.New(token, gohtb.WithRequestDeduplication())
+func WithRequestDeduplication() Option {
+	return func(c *Client) {
+		c.dedupRequests = true
+	}
+}
+
+// dedupKeyHeaders are the request headers that can change the response.
+var dedupKeyHeaders = []string{"Authorization", "Accept", "If-Modified-Since", "If-None-Match", "Range"}
+
+// dedupTransport coalesces identical in-flight GET requests.
+type dedupTransport struct {
+	underlying http.RoundTripper
+
+	mu    sync.Mutex
+	calls map[string]*dedupCall
+}
+
+// dedupCall is one shared round trip. The fields after done are written
+// before done is closed; waiters and claimed are guarded by the
+// transport's mutex.
+type dedupCall struct {
+	cancel  context.CancelFunc
+	waiters int
+	claimed bool
+
+	done chan struct{}
+	// resp is handed unbuffered to the only waiter when body is nil.
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+func newDedupTransport(underlying http.RoundTripper) *dedupTransport {
+	if underlying == nil {
+		underlying = http.DefaultTransport
+	}
+	return &dedupTransport{underlying: underlying, calls: make(map[string]*dedupCall)}
+}
+
+func (t *dedupTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if (req.Method != http.MethodGet && req.Method != http.MethodHead) || (req.Body != nil && req.Body != http.NoBody) {
+		return t.underlying.RoundTrip(req)
+	}
+	key := dedupKey(req)
+
+	t.mu.Lock()
+	call, shared := t.calls[key]
+	if shared {
+		call.waiters++
+	} else {
+		// The round trip must outlive the caller that started it while
+		// others wait on it, so it runs detached from the caller's
+		// cancellation and deadline and is cancelled once every waiter
+		// has left.
+		ctx, cancel := context.WithCancel(context.WithoutCancel(req.Context()))
+		call = &dedupCall{cancel: cancel, waiters: 1, done: make(chan struct{})}
+		t.calls[key] = call
+		go t.run(key, call, req.WithContext(ctx))
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-call.done:
+	case <-req.Context().Done():
+		t.leave(key, call)
+		return nil, req.Context().Err()
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	if call.body == nil {
+		resp := call.resp
+		stats := common.RetryStats{Attempts: common.Attempts(resp), TotalWait: common.TotalWait(resp)}
+		resp.Request = req
+		common.AttachRetryStats(resp, stats)
+		if shared {
+			common.MarkDeduplicated(resp)
+		}
+		return resp, nil
+	}
+	return call.copyFor(req, shared), nil
+}
+
+// run performs the shared round trip. The response is buffered only if
+// several callers are waiting for it.
+func (t *dedupTransport) run(key string, call *dedupCall, req *http.Request) {
+	defer close(call.done)
+	resp, err := t.underlying.RoundTrip(req)
+
+	t.mu.Lock()
+	if t.calls[key] == call {
+		delete(t.calls, key)
+	}
+	waiters := call.waiters
+	call.claimed = waiters > 0
+	t.mu.Unlock()
+
+	switch {
+	case err != nil:
+		call.cancel()
+		call.err = err
+	case waiters == 0:
+		resp.Body.Close()
+		call.cancel()
+		call.err = context.Canceled
+	case waiters == 1:
+		resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: call.cancel}
+		call.resp = resp
+	default:
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		call.cancel()
+		if err != nil {
+			call.err = err
+			return
+		}
+		call.resp, call.body = resp, body
+	}
+}
+
+// leave drops a waiter whose context ended. The round trip is cancelled
+// when none are left; if it already finished for this waiter alone, its
+// response is discarded.
+func (t *dedupTransport) leave(key string, call *dedupCall) {
+	t.mu.Lock()
+	call.waiters--
+	last := call.waiters == 0
+	claimed := call.claimed
+	if last && t.calls[key] == call {
+		delete(t.calls, key)
+	}
+	t.mu.Unlock()
+
+	if !last {
+		return
+	}
+	call.cancel()
+	if claimed {
+		<-call.done
+		if call.err == nil && call.body == nil {
+			call.resp.Body.Close()
+		}
+	}
+}
+
+// CloseIdleConnections forwards to the underlying transport.
+func (t *dedupTransport) CloseIdleConnections() {
+	closeIdleConnections(t.underlying)
+}
+
+// copyFor returns an independent copy of the shared response for req,
+// carrying the retry stats of the shared round trip.
+func (c *dedupCall) copyFor(req *http.Request, deduplicated bool) *http.Response {
+	resp := *c.resp
+	resp.Header = c.resp.Header.Clone()
+	resp.Trailer = c.resp.Trailer.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(c.body))
+	resp.Request = req
+	common.AttachRetryStats(&resp, common.RetryStats{
+		Attempts:  common.Attempts(c.resp),
+		TotalWait: common.TotalWait(c.resp),
+	})
+	if deduplicated {
+		common.MarkDeduplicated(&resp)
+	}
+	return &resp
+}
+
+func dedupKey(req *http.Request) string {
+	var b strings.Builder
+	b.WriteString(req.Method)
+	b.WriteByte(' ')
+	b.WriteString(req.URL.String())
+	for _, h := range dedupKeyHeaders {
+		b.WriteByte('\n')
+		b.WriteString(h)
+		b.WriteByte(':')
+		b.WriteString(strings.Join(req.Header.Values(h), ","))
+	}
+	return b.String()
+}
+
+// cancelBody releases the context of a passed-through response once its
+// body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,114 @@
+package gohtb
+
+import (
+	"fmt"
+)
+
+type deriveOptions struct {
+	shareLimiter bool
+	identity     string
+}
+
+// DeriveOption configures a client created with WithToken.
+type DeriveOption func(*deriveOptions)
+
+// WithSharedLimiter makes the derived client use the parent's rate limiter,
+// so both accounts draw from one request budget and back off together.
+// Without it the derived client gets a limiter of its own.
+func WithSharedLimiter() DeriveOption {
+	return func(o *deriveOptions) {
+		o.shareLimiter = true
+	}
+}
+
+// WithIdentity sets the label the derived client's requests carry in
+// Event.Identity. It defaults to the subject of the new token.
+func WithIdentity(label string) DeriveOption {
+	return func(o *deriveOptions) {
+		o.identity = label
+	}
+}
+
+// WithToken returns a client that authenticates with token but otherwise
+// shares this client's configuration and connection pool, so several
+// accounts can be driven from one process without building separate
+// transports. Each client only ever sends its own token.
+//
+// The derived client publishes to the same Events channel as its parent,
+// with Identity set to tell them apart. It has its own shutdown tracking:
+// closing it does not close the parent, and closing the parent does not
+// close it. A client created with WithHTTPClient shares that HTTP client.
+//
+// Example:
+//
+//	team, err := personal.WithToken(teamToken, gohtb.WithSharedLimiter(), gohtb.WithIdentity("team"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	info, err := team.Users.Info(ctx)
+func (c *Client) WithToken(token string, opts ...DeriveOption) (*Client, error) {
+	if token == "" {
+		return nil, fmt.Errorf("htb token is required")
+	}
+	if err := isLikelyJWT(token); err != nil {
+		return nil, err
+	}
+
+	var o deriveOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	d := &Client{
+		htbToken:      token,
+		server:        c.server,
+		logger:        c.logger,
+		userAgent:     c.userAgent,
+		timeout:       c.timeout,
+		debug:         c.debug,
+		retryConfig:   c.retryConfig,
+		inflight:      newInflightTracker(),
+		events:        c.events,
+		clock:         c.clock,
+		rawFlags:      c.rawFlags,
+		identity:      o.identity,
+		statusURL:     c.statusURL,
+		rateBurst:     c.rateBurst,
+		rateRefill:    c.rateRefill,
+		onWarning:     c.onWarning,
+		dedupRequests: c.dedupRequests,
+		stats:         c.stats,
+	}
+	if d.identity == "" {
+		if info, err := d.TokenInfo(); err == nil {
+			d.identity = info.Subject
+		}
+	}
+	if c.instanceLock != nil {
+		d.instanceLock = newInstanceLock()
+	}
+
+	base := c.baseHTTPClient
+	switch {
+	case o.shareLimiter:
+		d.rateLimiter = c.rateLimiter
+		d.apiTransport = c.apiTransport
+	case c.apiTransport != nil:
+		d.rateLimiter = d.newRateLimiter()
+		d.rateLimiter.events = d.events
+		d.apiTransport = NewAPITransport(c.apiTransport.underlying, d.rateLimiter, d.retryConfig, d.logger)
+		d.apiTransport.clock = d.clock
+		d.apiTransport.events = d.events
+		derived := *c.baseHTTPClient
+		derived.Transport = d.apiTransport
+		base = &derived
+	default:
+		d.rateLimiter = d.newRateLimiter()
+	}
+	d.baseHTTPClient = base
+
+	if err := d.init(base); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,95 @@
+package gohtb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTokenSendsOnlyItsOwnToken(t *testing.T) {
+	tokens := map[string]string{
+		"parent":  gohtbtest.Token("1"),
+		"derived": gohtbtest.Token("2"),
+	}
+	owner := map[string]string{}
+	for name, token := range tokens {
+		owner["Bearer "+token] = name
+	}
+
+	tests := []struct {
+		name    string
+		options []gohtb.Option
+		derive  []gohtb.DeriveOption
+	}{
+		{name: "own limiter"},
+		{name: "shared limiter", derive: []gohtb.DeriveOption{gohtb.WithSharedLimiter()}},
+		{name: "request deduplication", options: []gohtb.Option{gohtb.WithRequestDeduplication()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var problems []string
+			seen := map[string]int{}
+
+			// The user info endpoint answers with the name of the account
+			// whose token the request carried.
+			srv := gohtbtest.NewServer().HandleFunc("GetUserInfo", func(w http.ResponseWriter, r *http.Request) {
+				auth := r.Header.Values("Authorization")
+				mu.Lock()
+				if len(auth) != 1 {
+					problems = append(problems, fmt.Sprintf("%d Authorization headers: %q", len(auth), auth))
+				}
+				name := owner[strings.Join(auth, ",")]
+				seen[name]++
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"info":{"id":1,"name":%q}}`, name)
+			})
+			defer srv.Close()
+
+			options := append([]gohtb.Option{
+				gohtb.WithServer(srv.URL),
+				gohtb.WithRateLimit(100, time.Millisecond),
+			}, tt.options...)
+			parent, err := gohtb.New(tokens["parent"], options...)
+			require.NoError(t, err)
+			derived, err := parent.WithToken(tokens["derived"], tt.derive...)
+			require.NoError(t, err)
+			clients := map[string]*gohtb.Client{"parent": parent, "derived": derived}
+
+			const calls = 20
+			var wg sync.WaitGroup
+			for name, client := range clients {
+				for range calls {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						info, err := client.Users.Info(context.Background())
+						if assert.NoError(t, err) {
+							assert.Equal(t, name, info.Data.Info.Name, "%s got another account's response", name)
+						}
+					}()
+				}
+			}
+			wg.Wait()
+
+			assert.Empty(t, problems)
+			assert.Zero(t, seen[""], "requests with an unknown token")
+			if tt.options == nil {
+				assert.Equal(t, calls, seen["parent"])
+				assert.Equal(t, calls, seen["derived"])
+			}
+		})
+	}
+}
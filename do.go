@@ -0,0 +1,32 @@
+package gohtb
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Do sends req through the client's HTTP client, so it shares the rate
+// limiter, retry policy, redirect policy and shutdown tracking used by API
+// calls. It is intended for resources the generated clients do not cover,
+// such as avatar and badge images.
+//
+// The bearer token and User-Agent are added only when req targets the API
+// server's host; requests to any other host are sent as given, without
+// credentials.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if c.isAPIHost(req.URL) {
+		req = req.Clone(withIdentity(req.Context(), c.identity))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.htbToken))
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	return c.httpClient.Do(req)
+}
+
+func (c *Client) isAPIHost(u *url.URL) bool {
+	server, err := url.Parse(c.server)
+	if err != nil || u == nil {
+		return false
+	}
+	return u.Scheme == server.Scheme && u.Host == server.Host
+}
@@ -8,10 +8,32 @@ import (
 
 type APIError = errutil.APIError
 
+// ErrCooldown is wrapped by the APIError returned from spawn, reset, challenge
+// start and pwnbox start calls that were rejected by an active cooldown.
+type ErrCooldown = errutil.ErrCooldown
+
+// ErrDailyLimitReached is wrapped by the APIError returned from instance
+// operations that were rejected because a daily quota has been used up.
+type ErrDailyLimitReached = errutil.ErrDailyLimitReached
+
+// ErrMalformedFlag is returned by flag submission methods, before any request
+// is made, when the flag does not have the shape the target expects.
+// Disable the check with WithRawFlag.
+type ErrMalformedFlag = errutil.ErrMalformedFlag
+
+// ErrInsufficientScope is wrapped by the APIError returned from any call
+// rejected with 403 because the token lacks a scope. Scope names the
+// missing scope when the API says which one it is.
+type ErrInsufficientScope = errutil.ErrInsufficientScope
+
 var ErrUnauthorized = errors.New("unauthorized")
 var ErrForbidden = errors.New("forbidden")
 var ErrRateLimited = errors.New("rate limited")
 
+// ErrInvalidToken is returned by ValidateToken when the token is malformed or
+// rejected by the API.
+var ErrInvalidToken = errors.New("invalid token")
+
 func AsAPIError(err error) (*APIError, bool) {
 	var apiErr *APIError
 	ok := errors.As(err, &apiErr)
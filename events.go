@@ -0,0 +1,128 @@
+package gohtb
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// EventKind identifies what an Event reports.
+type EventKind string
+
+const (
+	// EventRateLimited is emitted when the API answers with 429 Too Many
+	// Requests.
+	EventRateLimited EventKind = "rate_limited"
+	// EventThrottled is emitted when the client's own rate limiter delays a
+	// request, either because the request budget is used up or because a
+	// global backoff is active. Wait is the delay about to be applied.
+	EventThrottled EventKind = "throttled"
+	// EventRetry is emitted before a failed request is retried. Attempt is
+	// the number of the retry about to be made and Wait the backoff before
+	// it.
+	EventRetry EventKind = "retry"
+	// EventWarning is emitted for each Deprecation, Sunset or Warning
+	// header in a response. Operation names the call and Warning holds the
+	// parsed header.
+	EventWarning EventKind = "warning"
+)
+
+// eventBufferSize is how many events are held for a slow consumer before
+// new ones are dropped.
+const eventBufferSize = 256
+
+// Event describes something the client did while serving requests. Fields
+// that do not apply to Kind are left zero.
+type Event struct {
+	Kind EventKind
+	Time time.Time
+	// Identity names the account that made the request: the token's
+	// subject, or the label given to WithToken. It is empty for throttling
+	// that is not tied to a single request.
+	Identity   string
+	Method     string
+	URL        string
+	StatusCode int
+	Attempt    int
+	Wait       time.Duration
+	Err        error
+	Operation  string
+	Warning    *Warning
+}
+
+// eventBus delivers events without ever blocking the request path. A nil
+// *eventBus discards everything.
+type eventBus struct {
+	ch      chan Event
+	dropped atomic.Uint64
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{ch: make(chan Event, eventBufferSize)}
+}
+
+func (b *eventBus) emit(e Event) {
+	if b == nil {
+		return
+	}
+	select {
+	case b.ch <- e:
+	default:
+		b.dropped.Add(1)
+	}
+}
+
+// requestEvent fills the request fields of an event from req, if any.
+func requestEvent(kind EventKind, now time.Time, req *http.Request) Event {
+	e := Event{Kind: kind, Time: now}
+	if req != nil {
+		e.Identity = identityFrom(req.Context())
+		e.Method = req.Method
+		if req.URL != nil {
+			e.URL = req.URL.String()
+		}
+	}
+	return e
+}
+
+type identityKey struct{}
+
+func withIdentity(ctx context.Context, identity string) context.Context {
+	if identity == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func identityFrom(ctx context.Context) string {
+	identity, _ := ctx.Value(identityKey{}).(string)
+	return identity
+}
+
+// Events returns the channel on which the client publishes Event values.
+// The same channel is returned on every call, so concurrent readers share
+// the stream rather than each receiving every event.
+//
+// Publishing never blocks requests: the channel buffers up to 256 events,
+// and events that arrive while the buffer is full are dropped and counted in
+// DroppedEvents. Events come from the client's built-in transport; a client
+// created with WithHTTPClient publishes only EventWarning, which is emitted
+// when responses are parsed.
+//
+// Example:
+//
+//	go func() {
+//		for e := range client.Events() {
+//			log.Printf("%s %s %s (wait %v)", e.Kind, e.Method, e.URL, e.Wait)
+//		}
+//	}()
+func (c *Client) Events() <-chan Event {
+	return c.events.ch
+}
+
+// DroppedEvents returns how many events were discarded because the Events
+// channel was full.
+func (c *Client) DroppedEvents() uint64 {
+	return c.events.dropped.Load()
+}
@@ -0,0 +1,336 @@
+// Package gohtbtest helps test code built on gohtb against HTB failures
+// without waiting for a real outage.
+//
+// A FaultSet is an http.RoundTripper that sits where the network would be.
+// Requests are matched to their OpenAPI operation ID, the same name
+// ResponseMeta.Operation reports, and a configured fault is returned in
+// place of, or on top of, the real response. Install it beneath the
+// client's rate limiting and retry layer with gohtb.WithTransport so the
+// SDK's own retries run against the faults:
+//
+//	faults := gohtbtest.Faults().
+//		On("GetSeasonRewards").Times(2).Return429(30 * time.Second).
+//		On("GetUserInfo").Times(1).Return500(`{"message":"Server Error"}`)
+//	client, err := gohtb.New(token,
+//		gohtb.WithServer(srv.URL),
+//		gohtb.WithTransport(faults.Transport(nil)),
+//	)
+//
+//	// ... exercise the client ...
+//	if got := faults.Calls("GetSeasonRewards"); got != 3 {
+//		t.Fatalf("GetSeasonRewards sent %d times, want 3", got)
+//	}
+//
+// Server is a fake API that serves canned responses by operation ID, and
+// Token makes a token the client accepts, so tests and examples need no
+// account or network.
+package gohtbtest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultSet holds the faults to inject, keyed by operation ID. Rules are
+// tried in the order they were added; the first one for the operation with
+// calls left applies. Requests no rule applies to pass through unchanged.
+type FaultSet struct {
+	mu    sync.Mutex
+	rules []*Rule
+	calls map[string]int
+}
+
+// Rule is one fault being configured for an operation. It takes effect once
+// one of its Return, Timeout, ResetConnection or SlowBody methods is called.
+type Rule struct {
+	set       *FaultSet
+	operation string
+	times     int
+	used      int
+	fault     fault
+	// forwards is set when the fault sends the request on itself.
+	forwards bool
+}
+
+type fault func(req *http.Request, next http.RoundTripper) (*http.Response, error)
+
+// Faults returns an empty FaultSet.
+func Faults() *FaultSet {
+	return &FaultSet{calls: map[string]int{}}
+}
+
+// On starts a rule for operation, an OpenAPI operation ID such as
+// "GetSeasonRewards". It panics if no generated endpoint has that ID, so a
+// typo fails the test instead of silently injecting nothing.
+func (f *FaultSet) On(operation string) *Rule {
+	if _, ok := routeIndex[operation]; !ok {
+		panic(fmt.Sprintf("gohtbtest: unknown operation %q", operation))
+	}
+	return &Rule{set: f, operation: operation}
+}
+
+// Times limits the rule to the first k matching requests; later requests
+// fall through to the next rule or to the real transport. Without it, or
+// with k of zero or less, the rule applies to every matching request.
+func (r *Rule) Times(k int) *Rule {
+	r.times = k
+	return r
+}
+
+// Timeout holds the request for after and then fails it with a network
+// timeout error, as a stalled connection would. The request is never sent.
+// It returns early with the context's error if the request is cancelled.
+func (r *Rule) Timeout(after time.Duration) *FaultSet {
+	return r.add(func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		t := time.NewTimer(after)
+		defer t.Stop()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-t.C:
+		}
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}
+	})
+}
+
+// Return500 answers with 500 Internal Server Error and body.
+func (r *Rule) Return500(body string) *FaultSet {
+	return r.ReturnStatus(http.StatusInternalServerError, body)
+}
+
+// Return429 answers with 429 Too Many Requests and a Retry-After header of
+// retryAfter, rounded up to whole seconds.
+func (r *Rule) Return429(retryAfter time.Duration) *FaultSet {
+	secs := int((retryAfter + time.Second - 1) / time.Second)
+	return r.add(func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		resp := response(req, http.StatusTooManyRequests, `{"message":"Too Many Attempts."}`)
+		resp.Header.Set("Retry-After", strconv.Itoa(secs))
+		return resp, nil
+	})
+}
+
+// ReturnStatus answers with the given status code and JSON body.
+func (r *Rule) ReturnStatus(code int, body string) *FaultSet {
+	return r.add(func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		return response(req, code, body), nil
+	})
+}
+
+// ResetConnection fails the request with a connection reset error, as if
+// the server dropped the connection before answering.
+func (r *Rule) ResetConnection() *FaultSet {
+	return r.add(func(*http.Request, http.RoundTripper) (*http.Response, error) {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	})
+}
+
+// SlowBody sends the request for real but delivers the response body chunk
+// bytes at a time, waiting every between chunks. Reads stop with the
+// request context's error if it is cancelled meanwhile.
+func (r *Rule) SlowBody(chunk int, every time.Duration) *FaultSet {
+	if chunk <= 0 {
+		chunk = 1
+	}
+	r.forwards = true
+	return r.add(func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = &dripBody{ctx: req.Context(), body: resp.Body, chunk: chunk, every: every}
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+		return resp, nil
+	})
+}
+
+func (r *Rule) add(fn fault) *FaultSet {
+	r.fault = fn
+	r.set.mu.Lock()
+	r.set.rules = append(r.set.rules, r)
+	r.set.mu.Unlock()
+	return r.set
+}
+
+// Calls reports how many requests for operation have reached the
+// FaultSet, faulted or not. Retries by the SDK count separately.
+func (f *FaultSet) Calls(operation string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[operation]
+}
+
+// Transport returns a round tripper that injects the configured faults and
+// sends everything else through next, or http.DefaultTransport if next is
+// nil.
+func (f *FaultSet) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &faultTransport{set: f, next: next}
+}
+
+type faultTransport struct {
+	set  *FaultSet
+	next http.RoundTripper
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := operationFor(req.Method, req.URL.Path)
+	if op == "" {
+		return t.next.RoundTrip(req)
+	}
+
+	t.set.mu.Lock()
+	t.set.calls[op]++
+	var apply *Rule
+	for _, r := range t.set.rules {
+		if r.operation != op || (r.times > 0 && r.used >= r.times) {
+			continue
+		}
+		r.used++
+		apply = r
+		break
+	}
+	t.set.mu.Unlock()
+
+	if apply == nil {
+		return t.next.RoundTrip(req)
+	}
+	if req.Body != nil && !apply.forwards {
+		req.Body.Close()
+	}
+	return apply.fault(req, t.next)
+}
+
+func response(req *http.Request, code int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+type dripBody struct {
+	ctx   context.Context
+	body  io.ReadCloser
+	chunk int
+	every time.Duration
+	read  bool
+}
+
+func (d *dripBody) Read(p []byte) (int, error) {
+	if d.read {
+		t := time.NewTimer(d.every)
+		select {
+		case <-d.ctx.Done():
+			t.Stop()
+			return 0, d.ctx.Err()
+		case <-t.C:
+		}
+	}
+	d.read = true
+	if len(p) > d.chunk {
+		p = p[:d.chunk]
+	}
+	return d.body.Read(p)
+}
+
+func (d *dripBody) Close() error {
+	return d.body.Close()
+}
+
+//go:generate go run gen_routes.go
+
+type route struct {
+	operation, method, version, path string
+}
+
+type compiledRoute struct {
+	operation string
+	method    string
+	version   string
+	pattern   *regexp.Regexp
+	literals  int
+}
+
+var (
+	routeIndex = map[string]bool{}
+	compiled   []compiledRoute
+)
+
+func init() {
+	for _, r := range routes {
+		routeIndex[r.operation] = true
+		segments := strings.Split(strings.TrimPrefix(r.path, "/"), "/")
+		literals := 0
+		for i, s := range segments {
+			if strings.Contains(s, "%s") {
+				segments[i] = strings.ReplaceAll(regexp.QuoteMeta(s), "%s", "[^/]+")
+				continue
+			}
+			segments[i] = regexp.QuoteMeta(s)
+			literals++
+		}
+		compiled = append(compiled, compiledRoute{
+			operation: r.operation,
+			method:    r.method,
+			version:   r.version,
+			pattern:   regexp.MustCompile("^/" + strings.Join(segments, "/") + "$"),
+			literals:  literals,
+		})
+	}
+}
+
+// operationFor maps a request to its operation ID, preferring the route
+// with the most literal segments when several templates match, so
+// /machine/active is not taken for /machine/{id}.
+func operationFor(method, path string) string {
+	version, rest := splitVersion(path)
+	if version == "" {
+		return ""
+	}
+	best, bestLiterals := "", -1
+	for _, r := range compiled {
+		if r.version != version || r.method != method || !r.pattern.MatchString(rest) {
+			continue
+		}
+		if r.literals > bestLiterals {
+			best, bestLiterals = r.operation, r.literals
+		}
+	}
+	return best
+}
+
+// splitVersion finds the API version segment in path, wherever the server
+// is mounted, and returns it with the remainder of the path.
+func splitVersion(path string) (string, string) {
+	for _, v := range []string{"v4", "v5"} {
+		marker := "/" + v + "/"
+		if i := strings.Index(path, marker); i >= 0 {
+			return v, path[i+len(marker)-1:]
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,172 @@
+package gohtbtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userInfo = `{"info":{"id":1,"name":"alice"}}`
+
+// newClient returns a client for srv whose requests pass through faults,
+// with retry waits run on a fake clock so backoff costs no real time.
+func newClient(t *testing.T, srv *gohtbtest.Server, faults *gohtbtest.FaultSet, maxRetries int) *gohtb.Client {
+	t.Helper()
+	clk := gohtb.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	client, err := gohtb.New(gohtbtest.Token("1"),
+		gohtb.WithServer(srv.URL),
+		gohtb.WithTransport(faults.Transport(nil)),
+		gohtb.WithClock(clk),
+		gohtb.WithRetry(gohtb.RetryConfig{MaxRetries: maxRetries, RetryPolicy: &gohtb.DefaultRetryPolicy{}}),
+	)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond):
+			}
+			if clk.Waiters() > 0 {
+				clk.Advance(time.Minute)
+			}
+		}
+	}()
+	return client
+}
+
+func TestFaultsRetryAfter429(t *testing.T) {
+	srv := gohtbtest.NewServer().JSON("GetUserInfo", userInfo)
+	defer srv.Close()
+	faults := gohtbtest.Faults().
+		On("GetUserInfo").Times(2).Return429(30 * time.Second)
+	client := newClient(t, srv, faults, 4)
+
+	info, err := client.Users.Info(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice", info.Data.Info.Name)
+	assert.Equal(t, 3, faults.Calls("GetUserInfo"))
+	assert.Equal(t, 3, info.ResponseMeta.Attempts)
+	assert.Equal(t, time.Minute, info.ResponseMeta.TotalWait, "each 429 waits its Retry-After")
+	assert.Len(t, srv.Requests("GetUserInfo"), 1)
+}
+
+func TestFaultsRetryExhausted(t *testing.T) {
+	tests := []struct {
+		name   string
+		faults *gohtbtest.FaultSet
+		check  func(t *testing.T, err error)
+	}{
+		{
+			name:   "500",
+			faults: gohtbtest.Faults().On("GetUserInfo").Return500(`{"message":"Server Error"}`),
+			check: func(t *testing.T, err error) {
+				apiErr, ok := gohtb.AsAPIError(err)
+				require.True(t, ok, "want an APIError, got %v", err)
+				assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+			},
+		},
+		{
+			name:   "503",
+			faults: gohtbtest.Faults().On("GetUserInfo").ReturnStatus(http.StatusServiceUnavailable, `{"message":"Maintenance"}`),
+			check: func(t *testing.T, err error) {
+				apiErr, ok := gohtb.AsAPIError(err)
+				require.True(t, ok, "want an APIError, got %v", err)
+				assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+			},
+		},
+		{
+			name:   "connection reset",
+			faults: gohtbtest.Faults().On("GetUserInfo").ResetConnection(),
+			check: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "connection reset")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gohtbtest.NewServer().JSON("GetUserInfo", userInfo)
+			defer srv.Close()
+			client := newClient(t, srv, tt.faults, 2)
+
+			_, err := client.Users.Info(context.Background())
+			require.Error(t, err)
+			tt.check(t, err)
+			assert.Equal(t, 3, tt.faults.Calls("GetUserInfo"), "one attempt and two retries")
+			assert.Empty(t, srv.Requests("GetUserInfo"), "no attempt reaches the server")
+		})
+	}
+}
+
+func TestFaultsRecoverAfterTransientFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		faults *gohtbtest.FaultSet
+		calls  int
+	}{
+		{"500 once", gohtbtest.Faults().On("GetUserInfo").Times(1).Return500(`{}`), 2},
+		{"reset twice", gohtbtest.Faults().On("GetUserInfo").Times(2).ResetConnection(), 3},
+		{"timeout once", gohtbtest.Faults().On("GetUserInfo").Times(1).Timeout(10 * time.Millisecond), 2},
+		{"slow body", gohtbtest.Faults().On("GetUserInfo").SlowBody(8, time.Millisecond), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gohtbtest.NewServer().JSON("GetUserInfo", userInfo)
+			defer srv.Close()
+			client := newClient(t, srv, tt.faults, 4)
+
+			info, err := client.Users.Info(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "alice", info.Data.Info.Name)
+			assert.Equal(t, tt.calls, tt.faults.Calls("GetUserInfo"))
+			assert.Equal(t, tt.calls, info.ResponseMeta.Attempts)
+		})
+	}
+}
+
+func TestFaultsClientErrorNotRetried(t *testing.T) {
+	srv := gohtbtest.NewServer().JSON("GetUserInfo", userInfo)
+	defer srv.Close()
+	faults := gohtbtest.Faults().
+		On("GetUserInfo").ReturnStatus(http.StatusForbidden, `{"message":"Forbidden"}`)
+	client := newClient(t, srv, faults, 4)
+
+	_, err := client.Users.Info(context.Background())
+	apiErr, ok := gohtb.AsAPIError(err)
+	require.True(t, ok, "want an APIError, got %v", err)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, 1, faults.Calls("GetUserInfo"))
+}
+
+func TestFaultsOnlyMatchingOperation(t *testing.T) {
+	srv := gohtbtest.NewServer().
+		JSON("GetUserInfo", userInfo).
+		JSON("GetUserSettings", `{"public":1}`)
+	defer srv.Close()
+	faults := gohtbtest.Faults().On("GetUserSettings").Return500(`{}`)
+	client := newClient(t, srv, faults, 1)
+
+	_, err := client.Users.Info(context.Background())
+	require.NoError(t, err)
+	_, err = client.Users.Settings(context.Background())
+	require.Error(t, err)
+
+	assert.Equal(t, 1, faults.Calls("GetUserInfo"))
+	assert.Equal(t, 2, faults.Calls("GetUserSettings"))
+}
+
+func TestFaultsUnknownOperation(t *testing.T) {
+	assert.Panics(t, func() { gohtbtest.Faults().On("GetNoSuchThing") })
+}
@@ -0,0 +1,94 @@
+//go:build ignore
+
+// gen_routes reads the generated API clients and writes routes.go, the table
+// that maps each OpenAPI operation ID to its method and path.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+)
+
+var (
+	opRe     = regexp.MustCompile(`^// New\w+Request(?:WithBody)? generates requests for (\w+)`)
+	pathRe   = regexp.MustCompile(`operationPath := fmt\.Sprintf\("([^"]*)"`)
+	methodRe = regexp.MustCompile(`http\.NewRequest\("(\w+)"`)
+)
+
+type route struct {
+	op, method, version, path string
+}
+
+func main() {
+	sources := []struct{ version, file string }{
+		{"v4", "../httpclient/v4/client.v4.gen.go"},
+		{"v5", "../httpclient/v5/client.v5.gen.go"},
+	}
+
+	seen := map[string]bool{}
+	var routes []route
+	for _, src := range sources {
+		f, err := os.Open(src.file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var cur route
+		sc := bufio.NewScanner(f)
+		sc.Buffer(make([]byte, 1024*1024), 1024*1024)
+		for sc.Scan() {
+			line := sc.Text()
+			if m := opRe.FindStringSubmatch(line); m != nil {
+				cur = route{op: m[1], version: src.version}
+				continue
+			}
+			if cur.op == "" {
+				continue
+			}
+			if m := pathRe.FindStringSubmatch(line); m != nil {
+				cur.path = m[1]
+				continue
+			}
+			if m := methodRe.FindStringSubmatch(line); m != nil && cur.path != "" {
+				cur.method = m[1]
+				key := cur.version + " " + cur.op
+				if !seen[key] {
+					seen[key] = true
+					routes = append(routes, cur)
+				}
+				cur = route{}
+			}
+		}
+		if err := sc.Err(); err != nil {
+			log.Fatal(err)
+		}
+		f.Close()
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].version != routes[j].version {
+			return routes[i].version < routes[j].version
+		}
+		return routes[i].op < routes[j].op
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_routes.go; DO NOT EDIT.\n\npackage gohtbtest\n\n")
+	buf.WriteString("var routes = []route{\n")
+	for _, r := range routes {
+		fmt.Fprintf(&buf, "\t{%q, %q, %q, %q},\n", r.op, r.method, r.version, r.path)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("routes.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
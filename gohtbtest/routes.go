@@ -0,0 +1,223 @@
+// Code generated by gen_routes.go; DO NOT EDIT.
+
+package gohtbtest
+
+var routes = []route{
+	{"DeleteTeamInviteReject", "DELETE", "v4", "/team/%s/invite/reject"},
+	{"GetAccessOvpnfileVpnIdTCP", "GET", "v4", "/access/ovpnfile/%s/0/1"},
+	{"GetAccessOvpnfileVpnIdUDP", "GET", "v4", "/access/ovpnfile/%s/0"},
+	{"GetAnnouncements", "GET", "v4", "/announcements"},
+	{"GetBadges", "GET", "v4", "/badges"},
+	{"GetCareerCompanies", "GET", "v4", "/career/companies"},
+	{"GetCareerCompany", "GET", "v4", "/career/company/%s"},
+	{"GetCareerFeatured", "GET", "v4", "/career/featured"},
+	{"GetCareerHistory", "GET", "v4", "/career/history"},
+	{"GetCareerInfo", "GET", "v4", "/career/info/%s"},
+	{"GetCareerProfile", "GET", "v4", "/career/profile"},
+	{"GetCareerRequests", "GET", "v4", "/career/requests"},
+	{"GetCareerSearch", "GET", "v4", "/career/search"},
+	{"GetCareerStats", "GET", "v4", "/career/stats"},
+	{"GetChallengeActivity", "GET", "v4", "/challenge/activity/%s"},
+	{"GetChallengeCategoriesList", "GET", "v4", "/challenge/categories/list"},
+	{"GetChallengeChangelog", "GET", "v4", "/challenge/changelog/%s"},
+	{"GetChallengeDownload", "GET", "v4", "/challenge/download/%s"},
+	{"GetChallengeInfo", "GET", "v4", "/challenge/info/%s"},
+	{"GetChallengeRecommended", "GET", "v4", "/challenge/recommended"},
+	{"GetChallengeRecommendedRetired", "GET", "v4", "/challenge/recommended/retired"},
+	{"GetChallengeReviewsUser", "GET", "v4", "/challenge/reviews/user/%s"},
+	{"GetChallengeSuggested", "GET", "v4", "/challenge/suggested"},
+	{"GetChallengeWriteup", "GET", "v4", "/challenge/%s/writeup"},
+	{"GetChallengeWriteupOfficial", "GET", "v4", "/challenge/%s/writeup/official"},
+	{"GetChallenges", "GET", "v4", "/challenges"},
+	{"GetChangelogs", "GET", "v4", "/changelogs"},
+	{"GetConnectionStatus", "GET", "v4", "/connection/status"},
+	{"GetConnectionStatusProductname", "GET", "v4", "/connection/status/%s"},
+	{"GetConnectionStatusProlab", "GET", "v4", "/connection/status/prolab/%s"},
+	{"GetConnections", "GET", "v4", "/connections"},
+	{"GetConnectionsServers", "GET", "v4", "/connections/servers"},
+	{"GetConnectionsServersProlab", "GET", "v4", "/connections/servers/prolab/%s"},
+	{"GetContentStats", "GET", "v4", "/content/stats"},
+	{"GetFortress", "GET", "v4", "/fortress/%s"},
+	{"GetFortressFlags", "GET", "v4", "/fortress/%s/flags"},
+	{"GetFortresses", "GET", "v4", "/fortresses"},
+	{"GetHomeBanner", "GET", "v4", "/home/banners"},
+	{"GetHomeRecommended", "GET", "v4", "/home/recommended"},
+	{"GetHomeUserProgress", "GET", "v4", "/home/user/progress"},
+	{"GetHomeUserTodo", "GET", "v4", "/home/user/todo"},
+	{"GetMachineActive", "GET", "v4", "/machine/active"},
+	{"GetMachineActivity", "GET", "v4", "/machine/activity/%s"},
+	{"GetMachineAdventure", "GET", "v4", "/machines/%s/adventure"},
+	{"GetMachineChangelog", "GET", "v4", "/machine/changelog/%s"},
+	{"GetMachineCreators", "GET", "v4", "/machine/creators/%s"},
+	{"GetMachineGraphActivity", "GET", "v4", "/machine/graph/activity/%s/%s"},
+	{"GetMachineGraphMatrix", "GET", "v4", "/machine/graph/matrix/%s"},
+	{"GetMachineGraphOwnsDifficulty", "GET", "v4", "/machine/graph/owns/difficulty/%s"},
+	{"GetMachineListRetiredPaginated", "GET", "v4", "/machine/list/retired/paginated"},
+	{"GetMachineOwnsTop", "GET", "v4", "/machine/owns/top/%s"},
+	{"GetMachinePaginated", "GET", "v4", "/machine/paginated"},
+	{"GetMachineProfile", "GET", "v4", "/machine/profile/%s"},
+	{"GetMachineRecommended", "GET", "v4", "/machine/recommended"},
+	{"GetMachineRecommendedRetired", "GET", "v4", "/machine/recommended/retired"},
+	{"GetMachineReviews", "GET", "v4", "/machine/reviews/%s"},
+	{"GetMachineReviewsUser", "GET", "v4", "/machine/reviews/user/%s"},
+	{"GetMachineTags", "GET", "v4", "/machine/tags/%s"},
+	{"GetMachineTasks", "GET", "v4", "/machines/%s/tasks"},
+	{"GetMachineTodoPaginated", "GET", "v4", "/machine/todo/paginated"},
+	{"GetMachineUnreleased", "GET", "v4", "/machine/unreleased"},
+	{"GetMachineWalkthroughOfficialFeedbackChoices", "GET", "v4", "/machine/walkthroughs/official/feedback-choices"},
+	{"GetMachineWalkthroughRandom", "GET", "v4", "/machine/walkthrough/random"},
+	{"GetMachineWalkthroughs", "GET", "v4", "/machine/walkthroughs/%s"},
+	{"GetMachineWalkthroughsLanguageList", "GET", "v4", "/machine/walkthroughs/language/list"},
+	{"GetMachineWriteup", "GET", "v4", "/machine/writeup/%s"},
+	{"GetNavigationMain", "GET", "v4", "/navigation/main"},
+	{"GetNotices", "GET", "v4", "/notices"},
+	{"GetProlabChangelogs", "GET", "v4", "/prolab/%s/changelogs"},
+	{"GetProlabFaq", "GET", "v4", "/prolab/%s/faq"},
+	{"GetProlabFlags", "GET", "v4", "/prolab/%s/flags"},
+	{"GetProlabInfo", "GET", "v4", "/prolab/%s/info"},
+	{"GetProlabMachines", "GET", "v4", "/prolab/%s/machines"},
+	{"GetProlabOverview", "GET", "v4", "/prolab/%s/overview"},
+	{"GetProlabProgress", "GET", "v4", "/prolab/%s/progress"},
+	{"GetProlabRating", "GET", "v4", "/prolab/%s/rating"},
+	{"GetProlabReviews", "GET", "v4", "/prolab/%s/reviews"},
+	{"GetProlabReviewsOverview", "GET", "v4", "/prolab/%s/reviews_overview"},
+	{"GetProlabSubscription", "GET", "v4", "/prolab/%s/subscription"},
+	{"GetProlabs", "GET", "v4", "/prolabs"},
+	{"GetPwnboxStatus", "GET", "v4", "/pwnbox/status"},
+	{"GetPwnboxUsage", "GET", "v4", "/pwnbox/usage"},
+	{"GetRankings", "GET", "v4", "/rankings"},
+	{"GetRankingsCountries", "GET", "v4", "/rankings/countries"},
+	{"GetRankingsCountryBest", "GET", "v4", "/rankings/country/best"},
+	{"GetRankingsCountryOverview", "GET", "v4", "/rankings/country/overview"},
+	{"GetRankingsCountryRankingBracket", "GET", "v4", "/rankings/country/ranking_bracket"},
+	{"GetRankingsCountryUSMembers", "GET", "v4", "/rankings/country/%s/members"},
+	{"GetRankingsTeamBest", "GET", "v4", "/rankings/team/best"},
+	{"GetRankingsTeamBestId", "GET", "v4", "/rankings/team/best/%s"},
+	{"GetRankingsTeamOverview", "GET", "v4", "/rankings/team/overview"},
+	{"GetRankingsTeamOverviewId", "GET", "v4", "/rankings/team/overview/%s"},
+	{"GetRankingsTeamRankingBracket", "GET", "v4", "/rankings/team/ranking_bracket"},
+	{"GetRankingsTeamRankingBracketId", "GET", "v4", "/rankings/team/ranking_bracket/%s"},
+	{"GetRankingsTeams", "GET", "v4", "/rankings/teams"},
+	{"GetRankingsUniversities", "GET", "v4", "/rankings/universities"},
+	{"GetRankingsUniversityRankingBracketId", "GET", "v4", "/rankings/university/ranking_bracket/%s"},
+	{"GetRankingsUserBest", "GET", "v4", "/rankings/user/best"},
+	{"GetRankingsUserOverview", "GET", "v4", "/rankings/user/overview"},
+	{"GetRankingsUserRankingBracket", "GET", "v4", "/rankings/user/ranking_bracket"},
+	{"GetRankingsUsers", "GET", "v4", "/rankings/users"},
+	{"GetReview", "GET", "v4", "/review/%s/%s"},
+	{"GetReviewPaginated", "GET", "v4", "/review/%s/%s/paginated"},
+	{"GetSPProfile", "GET", "v4", "/sp/profile/%s"},
+	{"GetSPTier", "GET", "v4", "/sp/tier/%s"},
+	{"GetSPTiersProgress", "GET", "v4", "/sp/tiers/progress"},
+	{"GetSearchFetch", "GET", "v4", "/search/fetch"},
+	{"GetSeasonEnd", "GET", "v4", "/season/end/%s/%s"},
+	{"GetSeasonLeaderboard", "GET", "v4", "/season/%s/leaderboard"},
+	{"GetSeasonLeaderboardTop", "GET", "v4", "/season/%s/leaderboard/top/%s"},
+	{"GetSeasonList", "GET", "v4", "/season/list"},
+	{"GetSeasonMachineActive", "GET", "v4", "/season/machine/active"},
+	{"GetSeasonMachines", "GET", "v4", "/season/machines"},
+	{"GetSeasonMachinesCompleted", "GET", "v4", "/season/machines/completed/%s"},
+	{"GetSeasonRewards", "GET", "v4", "/season/rewards/%s"},
+	{"GetSeasonUserFollowers", "GET", "v4", "/season/user/followers/%s"},
+	{"GetSeasonUserRank", "GET", "v4", "/season/user/rank/%s"},
+	{"GetSeasonUserUserIdRank", "GET", "v4", "/season/user/%s/ranks"},
+	{"GetSherlock", "GET", "v4", "/sherlocks/%s"},
+	{"GetSherlockDownloadlink", "GET", "v4", "/sherlocks/%s/download_link"},
+	{"GetSherlockInfo", "GET", "v4", "/sherlocks/%s/info"},
+	{"GetSherlockPlay", "GET", "v4", "/sherlocks/%s/play"},
+	{"GetSherlockProgress", "GET", "v4", "/sherlocks/%s/progress"},
+	{"GetSherlockTasks", "GET", "v4", "/sherlocks/%s/tasks"},
+	{"GetSherlockWriteup", "GET", "v4", "/sherlocks/%s/writeup"},
+	{"GetSherlockWriteupOfficial", "GET", "v4", "/sherlocks/%s/writeup/official"},
+	{"GetSherlocks", "GET", "v4", "/sherlocks"},
+	{"GetSherlocksCategoriesList", "GET", "v4", "/sherlocks/categories/list"},
+	{"GetSidebarAnnouncement", "GET", "v4", "/sidebar/announcement"},
+	{"GetSidebarChangelog", "GET", "v4", "/sidebar/changelog"},
+	{"GetTagsList", "GET", "v4", "/tags/list"},
+	{"GetTeamActivity", "GET", "v4", "/team/activity/%s"},
+	{"GetTeamChartChallengeCategories", "GET", "v4", "/team/chart/challenge/categories/%s"},
+	{"GetTeamChartMachinesAttack", "GET", "v4", "/team/chart/machines/attack/%s"},
+	{"GetTeamGraph", "GET", "v4", "/team/graph/%s"},
+	{"GetTeamInfo", "GET", "v4", "/team/info/%s"},
+	{"GetTeamInvitations", "GET", "v4", "/team/invitations/%s"},
+	{"GetTeamMembers", "GET", "v4", "/team/members/%s"},
+	{"GetTeamStatsOwns", "GET", "v4", "/team/stats/owns/%s"},
+	{"GetTracks", "GET", "v4", "/tracks"},
+	{"GetTracksId", "GET", "v4", "/tracks/%s"},
+	{"GetUniversityActivity", "GET", "v4", "/university/activity/%s"},
+	{"GetUniversityAllList", "GET", "v4", "/university/all/list"},
+	{"GetUniversityChartChallengeCategories", "GET", "v4", "/university/chart/challenge/categories/%s"},
+	{"GetUniversityChartMachinesAttack", "GET", "v4", "/university/chart/machines/attack/%s"},
+	{"GetUniversityCountryList", "GET", "v4", "/university/country/list"},
+	{"GetUniversityMembers", "GET", "v4", "/university/members/%s"},
+	{"GetUniversityNewList", "GET", "v4", "/university/new/list"},
+	{"GetUniversityProfile", "GET", "v4", "/university/profile/%s"},
+	{"GetUniversityStatsOwns", "GET", "v4", "/university/stats/owns/%s"},
+	{"GetUniversityTopList", "GET", "v4", "/university/top/list"},
+	{"GetUserAchievement", "GET", "v4", "/user/achievement/%s/%s/%s"},
+	{"GetUserAnonymizedId", "GET", "v4", "/user/anonymized/id"},
+	{"GetUserApptokenList", "GET", "v4", "/user/apptoken/list"},
+	{"GetUserConnectionStatus", "GET", "v4", "/user/connection/status"},
+	{"GetUserDashboard", "GET", "v4", "/user/dashboard"},
+	{"GetUserDashboardTabloid", "GET", "v4", "/user/dashboard/tabloid"},
+	{"GetUserFollowers", "GET", "v4", "/user/followers"},
+	{"GetUserInfo", "GET", "v4", "/user/info"},
+	{"GetUserProfileActivity", "GET", "v4", "/user/profile/activity/%s"},
+	{"GetUserProfileBadges", "GET", "v4", "/user/profile/badges/%s"},
+	{"GetUserProfileBasic", "GET", "v4", "/user/profile/basic/%s"},
+	{"GetUserProfileBloods", "GET", "v4", "/user/profile/bloods/%s"},
+	{"GetUserProfileChartMachinesAttack", "GET", "v4", "/user/profile/chart/machines/attack/%s"},
+	{"GetUserProfileContent", "GET", "v4", "/user/profile/content/%s"},
+	{"GetUserProfileGraph", "GET", "v4", "/user/profile/graph/%s/%s"},
+	{"GetUserProfileProgressChallenges", "GET", "v4", "/user/profile/progress/challenges/%s"},
+	{"GetUserProfileProgressFortress", "GET", "v4", "/user/profile/progress/fortress/%s"},
+	{"GetUserProfileProgressProlab", "GET", "v4", "/user/profile/progress/prolab/%s"},
+	{"GetUserProfileProgressSherlocks", "GET", "v4", "/user/profile/progress/sherlocks/%s"},
+	{"GetUserProfileSummary", "GET", "v4", "/user/profile/summary"},
+	{"GetUserSettings", "GET", "v4", "/user/settings"},
+	{"GetUserTracks", "GET", "v4", "/user/tracks"},
+	{"PostArenaOwn", "POST", "v4", "/arena/own"},
+	{"PostCareerTemporaryContactOptin", "POST", "v4", "/career/temporary/contact/optin"},
+	{"PostChallengeHelpfull", "POST", "v4", "/challenge/review/helpful/%s"},
+	{"PostChallengeOwn", "POST", "v4", "/challenge/own"},
+	{"PostChallengeReview", "POST", "v4", "/challenge/review"},
+	{"PostChallengeStart", "POST", "v4", "/challenge/start"},
+	{"PostChallengeStop", "POST", "v4", "/challenge/stop"},
+	{"PostConnectionsServersSwitch", "POST", "v4", "/connections/servers/switch/%s"},
+	{"PostContainerStart", "POST", "v4", "/container/start"},
+	{"PostContainerStop", "POST", "v4", "/container/stop"},
+	{"PostFortressFlag", "POST", "v4", "/fortress/%s/flag"},
+	{"PostFortressReset", "POST", "v4", "/fortress/%s/reset"},
+	{"PostMachineOwn", "POST", "v4", "/machine/own"},
+	{"PostMachineReview", "POST", "v4", "/machine/review"},
+	{"PostProlabFlag", "POST", "v4", "/prolab/%s/flag"},
+	{"PostPwnboxStart", "POST", "v4", "/pwnbox/start"},
+	{"PostPwnboxTerminate", "POST", "v4", "/pwnbox/terminate"},
+	{"PostSPTaskFlag", "POST", "v4", "/sp/task/flag"},
+	{"PostSherlockTasksFlag", "POST", "v4", "/sherlocks/%s/tasks/%s/flag"},
+	{"PostTeamInviteAccept", "POST", "v4", "/team/%s/invite/accept"},
+	{"PostTeamKickUser", "POST", "v4", "/team/kick/%s"},
+	{"PostTodoUpdate", "POST", "v4", "/%s/todo/update/%s"},
+	{"PostTracksEnroll", "POST", "v4", "/tracks/enroll/%s"},
+	{"PostTracksLike", "POST", "v4", "/tracks/like/%s"},
+	{"PostUserApptokenCreate", "POST", "v4", "/user/apptoken/create"},
+	{"PostUserApptokenDelete", "POST", "v4", "/user/apptoken/delete"},
+	{"PostUserDisrespect", "POST", "v4", "/user/disrespect/%s"},
+	{"PostUserFollow", "POST", "v4", "/user/follow/%s"},
+	{"PostUserRespect", "POST", "v4", "/user/respect/%s"},
+	{"PostUserUnfollow", "POST", "v4", "/user/unfollow/%s"},
+	{"PostVMExtend", "POST", "v4", "/vm/extend"},
+	{"PostVMReset", "POST", "v4", "/vm/reset"},
+	{"PostVMResetVote", "POST", "v4", "/vm/reset/vote"},
+	{"PostVMResetVoteAccept", "POST", "v4", "/vm/reset/vote/accept"},
+	{"PostVMSpawn", "POST", "v4", "/vm/spawn"},
+	{"PostVMTerminate", "POST", "v4", "/vm/terminate"},
+	{"GetConnections", "GET", "v5", "/connections"},
+	{"GetMachines", "GET", "v5", "/machines"},
+	{"GetUserDashboardFavorites", "GET", "v5", "/user/dashboard/favorites"},
+	{"GetUserDashboardInProgress", "GET", "v5", "/user/dashboard/inprogress"},
+	{"GetUserDashboardRecommended", "GET", "v5", "/user/dashboard/recommended"},
+	{"GetUserProfileActivity", "GET", "v5", "/user/profile/activity/%s"},
+	{"GetUserProfileContent", "GET", "v5", "/user/profile/content/%s"},
+	{"PostMachineOwn", "POST", "v5", "/machine/own"},
+}
@@ -0,0 +1,101 @@
+package gohtbtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// Server is a fake HTB API for tests and examples. Responses are registered
+// per OpenAPI operation ID, like faults, and requests for operations
+// without one are answered with 404 Not Found. Point a client at it with
+// gohtb.WithServer:
+//
+//	srv := gohtbtest.NewServer().
+//		JSON("GetUserInfo", `{"info":{"id":1,"name":"alice"}}`)
+//	defer srv.Close()
+//	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests map[string][]*http.Request
+}
+
+// NewServer starts a Server with no responses registered. Close it when
+// done.
+func NewServer() *Server {
+	s := &Server{
+		handlers: map[string]http.HandlerFunc{},
+		requests: map[string][]*http.Request{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// JSON answers operation with 200 OK and body.
+func (s *Server) JSON(operation, body string) *Server {
+	return s.Status(operation, http.StatusOK, body)
+}
+
+// Status answers operation with code and the JSON body.
+func (s *Server) Status(operation string, code int, body string) *Server {
+	return s.HandleFunc(operation, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleFunc answers operation with fn, for responses that depend on the
+// request, such as paged lists. Like FaultSet.On it panics on an unknown
+// operation ID. A later registration for the same operation replaces the
+// earlier one.
+func (s *Server) HandleFunc(operation string, fn http.HandlerFunc) *Server {
+	if _, ok := routeIndex[operation]; !ok {
+		panic(fmt.Sprintf("gohtbtest: unknown operation %q", operation))
+	}
+	s.mu.Lock()
+	s.handlers[operation] = fn
+	s.mu.Unlock()
+	return s
+}
+
+// Requests returns the requests received for operation, in arrival order.
+// Their bodies have been consumed.
+func (s *Server) Requests(operation string) []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests[operation]...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	op := operationFor(r.Method, r.URL.Path)
+
+	s.mu.Lock()
+	s.requests[op] = append(s.requests[op], r.Clone(r.Context()))
+	fn := s.handlers[op]
+	s.mu.Unlock()
+
+	if fn == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"message":"no response for %s %s"}`, r.Method, r.URL.Path)
+		return
+	}
+	fn(w, r)
+}
+
+// Token returns an unsigned JWT for subject that gohtb.New accepts. It
+// grants every scope and never expires, and is only good against a fake
+// server.
+func Token(subject string) string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims, _ := json.Marshal(map[string]any{"sub": subject, "scopes": []string{"*"}})
+	return header + "." + enc.EncodeToString(claims) + "."
+}
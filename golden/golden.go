@@ -0,0 +1,224 @@
+// Package golden turns gohtb response values into stable JSON for snapshot
+// tests and compares them against golden files.
+//
+// encoding/json already writes map keys in sorted order, so maps in response
+// types marshal deterministically. What varies between otherwise identical
+// calls is request metadata and the order of a few lists the API does not
+// keep stable. Marshal therefore:
+//
+//   - drops ResponseMeta, whether it is a named field or embedded, since it
+//     holds headers, timings and the raw body;
+//   - sorts the arrays under keys the API is known to shuffle, tags by
+//     default, plus any named with Unordered;
+//   - indents with two spaces and ends with a newline.
+//
+// Arrays under any other key keep the order the service returned, which is
+// significant for leaderboards, activity feeds and paginated lists.
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// UpdateEnv is the environment variable that makes Assert rewrite golden
+// files instead of comparing against them.
+const UpdateEnv = "GOHTB_UPDATE_GOLDEN"
+
+// defaultUnordered lists keys whose arrays the API returns in varying order.
+var defaultUnordered = []string{"tags", "Tags"}
+
+// metaKeys are the fields of an embedded ResponseMeta.
+var metaKeys = []string{"Raw", "StatusCode", "Headers", "CFRay", "RequestID", "QueueWait", "Operation", "Attempts", "TotalWait", "NotModified", "Deduplicated", "Warnings"}
+
+type options struct {
+	unordered map[string]bool
+	ignored   map[string]bool
+}
+
+// Option configures Marshal and Assert.
+type Option func(*options)
+
+// Unordered sorts the arrays found under the given object keys, at any
+// depth, in addition to the defaults.
+func Unordered(keys ...string) Option {
+	return func(o *options) {
+		for _, k := range keys {
+			o.unordered[k] = true
+		}
+	}
+}
+
+// Ignore removes the given object keys, at any depth, before comparison.
+// Use it for fields that change on every call, such as relative times.
+func Ignore(keys ...string) Option {
+	return func(o *options) {
+		for _, k := range keys {
+			o.ignored[k] = true
+		}
+	}
+}
+
+// Marshal returns the normalized JSON form of v.
+//
+// Example:
+//
+//	resp, err := client.Machines.Machine(12345).Info(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	out, err := golden.Marshal(resp)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Print(string(out))
+func Marshal(v any, opts ...Option) ([]byte, error) {
+	o := options{
+		unordered: map[string]bool{},
+		ignored:   map[string]bool{"ResponseMeta": true},
+	}
+	for _, k := range defaultUnordered {
+		o.unordered[k] = true
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	out, err := json.MarshalIndent(normalize(tree, "", &o), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+func normalize(v any, key string, o *options) any {
+	switch t := v.(type) {
+	case map[string]any:
+		if isEmbeddedMeta(t) {
+			for _, k := range metaKeys {
+				delete(t, k)
+			}
+		}
+		for k, child := range t {
+			if o.ignored[k] {
+				delete(t, k)
+				continue
+			}
+			t[k] = normalize(child, k, o)
+		}
+		return t
+	case []any:
+		for i, child := range t {
+			t[i] = normalize(child, key, o)
+		}
+		if o.unordered[key] {
+			sortByJSON(t)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// isEmbeddedMeta reports whether m carries the promoted fields of an
+// embedded ResponseMeta.
+func isEmbeddedMeta(m map[string]any) bool {
+	_, raw := m["Raw"]
+	_, headers := m["Headers"]
+	_, ray := m["CFRay"]
+	return raw && headers && ray
+}
+
+func sortByJSON(items []any) {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		b, _ := json.Marshal(item)
+		keys[i] = string(b)
+	}
+	sort.Sort(byKey{items, keys})
+}
+
+type byKey struct {
+	items []any
+	keys  []string
+}
+
+func (b byKey) Len() int           { return len(b.items) }
+func (b byKey) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b byKey) Swap(i, j int) {
+	b.items[i], b.items[j] = b.items[j], b.items[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+// Assert compares the normalized JSON of v with the golden file at path and
+// fails t on any difference. When the GOHTB_UPDATE_GOLDEN environment
+// variable is set to a non-empty value, the file is written instead.
+//
+// Example:
+//
+//	func TestMachineReport(t *testing.T) {
+//		report := buildReport(t)
+//		golden.Assert(t, "testdata/machine_report.json", report)
+//	}
+func Assert(t testing.TB, path string, v any, opts ...Option) {
+	t.Helper()
+
+	got, err := Marshal(v, opts...)
+	if err != nil {
+		t.Fatalf("golden: marshal: %v", err)
+	}
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("golden: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden: %v (set %s=1 to create it)", err, UpdateEnv)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("golden: %s does not match (set %s=1 to update)\n%s", path, UpdateEnv, firstDiff(string(want), string(got)))
+	}
+}
+
+// firstDiff describes the first line that differs between want and got.
+func firstDiff(want, got string) string {
+	w := strings.Split(want, "\n")
+	g := strings.Split(got, "\n")
+	for i := 0; i < max(len(w), len(g)); i++ {
+		var wl, gl string
+		if i < len(w) {
+			wl = w[i]
+		}
+		if i < len(g) {
+			gl = g[i]
+		}
+		if wl != gl {
+			return fmt.Sprintf("line %d:\n  want: %s\n  got:  %s", i+1, wl, gl)
+		}
+	}
+	return ""
+}
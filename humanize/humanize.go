@@ -0,0 +1,84 @@
+// Package humanize formats timestamps from API responses as short relative
+// phrases such as "3 days ago" or "in 2 weeks", for display in user
+// interfaces.
+package humanize
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	day   = 24 * time.Hour
+	week  = 7 * day
+	month = 30 * day
+	year  = 365 * day
+)
+
+// units is checked from largest to smallest; the first unit that fits at
+// least once is used. Months and years are fixed lengths of 30 and 365
+// days, so the output depends only on the difference between the times.
+var units = []struct {
+	size     time.Duration
+	singular string
+	plural   string
+}{
+	{year, "year", "years"},
+	{month, "month", "months"},
+	{week, "week", "weeks"},
+	{day, "day", "days"},
+	{time.Hour, "hour", "hours"},
+	{time.Minute, "minute", "minutes"},
+	{time.Second, "second", "seconds"},
+}
+
+// Relative describes t relative to now: "3 days ago" for a time in the
+// past, "in 2 weeks" for one in the future, and "just now" when they are
+// less than a second apart. The count is rounded down in the largest unit
+// that fits, so 47 hours is "1 day ago" and exactly 24 hours is also
+// "1 day ago". A zero t returns an empty string.
+//
+// The result depends only on t and now, never on the wall clock or the
+// local time zone.
+//
+// Example:
+//
+//	fmt.Println(humanize.Relative(release, time.Now())) // "3 days ago"
+func Relative(t, now time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	d := t.Sub(now)
+	future := d > 0
+	if !future {
+		d = -d
+	}
+	if d < time.Second {
+		return "just now"
+	}
+
+	u := units[len(units)-1]
+	for _, candidate := range units {
+		if d >= candidate.size {
+			u = candidate
+			break
+		}
+	}
+	n := int64(d / u.size)
+	name := u.plural
+	if n == 1 {
+		name = u.singular
+	}
+
+	buf := make([]byte, 0, 24)
+	if future {
+		buf = append(buf, "in "...)
+	}
+	buf = strconv.AppendInt(buf, n, 10)
+	buf = append(buf, ' ')
+	buf = append(buf, name...)
+	if !future {
+		buf = append(buf, " ago"...)
+	}
+	return string(buf)
+}
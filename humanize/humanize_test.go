@@ -0,0 +1,71 @@
+package humanize_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb/humanize"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelative(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		want   string
+	}{
+		{"same instant", 0, "just now"},
+		{"under a second ago", -999 * time.Millisecond, "just now"},
+		{"under a second ahead", 999 * time.Millisecond, "just now"},
+		{"one second ago", -time.Second, "1 second ago"},
+		{"one second ahead", time.Second, "in 1 second"},
+		{"seconds", -59 * time.Second, "59 seconds ago"},
+		{"seconds roll over to a minute", -60 * time.Second, "1 minute ago"},
+		{"minutes", -59*time.Minute - 59*time.Second, "59 minutes ago"},
+		{"minutes roll over to an hour", -time.Hour, "1 hour ago"},
+		{"hours", -23*time.Hour - 59*time.Minute, "23 hours ago"},
+		{"exactly one day ago", -day, "1 day ago"},
+		{"exactly one day ahead", day, "in 1 day"},
+		{"47 hours rounds down", -47 * time.Hour, "1 day ago"},
+		{"two days", -2 * day, "2 days ago"},
+		{"six days", -6 * day, "6 days ago"},
+		{"days roll over to a week", -7 * day, "1 week ago"},
+		{"weeks", -29 * day, "4 weeks ago"},
+		{"weeks roll over to a month", -30 * day, "1 month ago"},
+		{"months", -364 * day, "12 months ago"},
+		{"months roll over to a year", -365 * day, "1 year ago"},
+		{"years ahead", 3 * 365 * day, "in 3 years"},
+		{"two weeks ahead", 14 * day, "in 2 weeks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, humanize.Relative(now.Add(tt.offset), now))
+		})
+	}
+}
+
+func TestRelativeZeroTime(t *testing.T) {
+	assert.Equal(t, "", humanize.Relative(time.Time{}, time.Now()))
+}
+
+func TestRelativeIgnoresTimeZone(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	then := now.Add(-3 * 24 * time.Hour).In(time.FixedZone("UTC+9", 9*60*60))
+	assert.Equal(t, "3 days ago", humanize.Relative(then, now))
+}
+
+func ExampleRelative() {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	fmt.Println(humanize.Relative(now.Add(-72*time.Hour), now))
+	fmt.Println(humanize.Relative(now.Add(14*24*time.Hour), now))
+	fmt.Println(humanize.Relative(now, now))
+	// Output:
+	// 3 days ago
+	// in 2 weeks
+	// just now
+}
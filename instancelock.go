@@ -0,0 +1,42 @@
+package gohtb
+
+import "context"
+
+// instanceLock is a mutex whose waiters give up when their context is done.
+type instanceLock chan struct{}
+
+func newInstanceLock() instanceLock {
+	return make(instanceLock, 1)
+}
+
+func (l instanceLock) lock(ctx context.Context) (func(), error) {
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// WithSerializedInstanceOps makes the client run at most one instance-mutating
+// call at a time: VM spawn, reset, extend and terminate, container start and
+// stop, and Pwnbox start and terminate. Other calls wait in a queue until the
+// running one returns, or fail with the context error if ctx is done first.
+//
+// The time spent queued is reported in ResponseMeta.QueueWait.
+//
+// Example:
+//
+//	client, err := gohtb.New(token, gohtb.WithSerializedInstanceOps())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	resp, err := client.VMs.VM(12345).Spawn(ctx)
+//	if err == nil && resp.ResponseMeta.QueueWait > 0 {
+//		log.Printf("spawn waited %s for another instance call", resp.ResponseMeta.QueueWait)
+//	}
+func WithSerializedInstanceOps() Option {
+	return func(c *Client) {
+		c.instanceLock = newInstanceLock()
+	}
+}
@@ -0,0 +1,108 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// DefaultConcurrency is used when a caller passes a non-positive limit.
+const DefaultConcurrency = 4
+
+// ForEach calls fn for every index in [0, n) with at most limit calls running
+// at once. Every call still goes through the client's rate limiter, so limit
+// only bounds how many requests may be queued on it at the same time.
+//
+// ForEach waits for all started calls to return. It stops scheduling new calls
+// once ctx is done and returns ctx.Err() in that case.
+func ForEach(ctx context.Context, n, limit int, fn func(ctx context.Context, i int)) error {
+	if limit <= 0 {
+		limit = DefaultConcurrency
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(ctx, i)
+		}(i)
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
+
+// Result holds the outcome of a fan-out, keyed by the index of each input.
+// Every index is in exactly one of Succeeded and Failed, unless the run
+// was cut short by its context.
+type Result[T any] struct {
+	Succeeded map[int]T
+	Failed    map[int]error
+}
+
+// HasErrors reports whether any input failed.
+func (r Result[T]) HasErrors() bool {
+	return len(r.Failed) > 0
+}
+
+// FirstError returns the error of the lowest failed index, or nil.
+func (r Result[T]) FirstError() error {
+	first := -1
+	for i := range r.Failed {
+		if first < 0 || i < first {
+			first = i
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+	return r.Failed[first]
+}
+
+// Err joins the errors of all failed inputs in index order, or returns nil.
+func (r Result[T]) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	idx := slices.Sorted(maps.Keys(r.Failed))
+	errs := make([]error, len(idx))
+	for j, i := range idx {
+		errs[j] = r.Failed[i]
+	}
+	return errors.Join(errs...)
+}
+
+// Collect runs fn for every index in [0, n) like ForEach and gathers what
+// each call returns. The error is ctx.Err() if ctx was done before every
+// call was scheduled; failures of individual calls are only in the result.
+func Collect[T any](ctx context.Context, n, limit int, fn func(ctx context.Context, i int) (T, error)) (Result[T], error) {
+	res := Result[T]{
+		Succeeded: make(map[int]T, n),
+		Failed:    make(map[int]error),
+	}
+	var mu sync.Mutex
+	err := ForEach(ctx, n, limit, func(ctx context.Context, i int) {
+		v, err := fn(ctx, i)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			res.Failed[i] = err
+			return
+		}
+		res.Succeeded[i] = v
+	})
+	return res, err
+}
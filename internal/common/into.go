@@ -0,0 +1,128 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/errutil"
+	"github.com/gubarz/gohtb/internal/extract"
+)
+
+// ParseInto decodes resp into out without a generated parser and builds the
+// same ResponseMeta as Parse. It is meant for new endpoints that have no
+// generated client yet.
+//
+// The payload is read from the "data" member when the body is an object that
+// has one, and from the whole body otherwise. A struct T with its own "data"
+// field, such as a generated envelope type, receives the whole body. Both envelope shapes the API
+// uses are accepted whatever T is:
+//
+//   - T is a slice: a data array is decoded as is, a single data object
+//     becomes a one-element slice, and null becomes an empty slice.
+//   - T is not a slice: a data object is decoded as is, and a data array
+//     must hold at most one element.
+//
+// operation is the endpoint's OpenAPI operation ID. It is stamped on the
+// ResponseMeta and on any *APIError, and warnings are reported under it,
+// as Parse does with the ID it derives from the generated type.
+//
+// Any non-2xx status is reported as an *APIError, like Parse.
+//
+// Example:
+//
+//	var items []v4Client.Item
+//	meta, err := common.ParseInto(resp, "GetItems", &items)
+func ParseInto[T any](resp *http.Response, operation string, out *T) (meta ResponseMeta, err error) {
+	raw := extract.Raw(resp)
+	meta = NewMeta(resp, raw, operation)
+	defer func() {
+		var apiErr *errutil.APIError
+		if errors.As(err, &apiErr) && apiErr.Operation == "" {
+			apiErr.Operation = operation
+		}
+	}()
+
+	if resp == nil {
+		_, err = errutil.UnwrapFailure(errors.New("nil HTTP response"), raw, meta.StatusCode, func([]byte) any { return nil })
+		return meta, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_, err = errutil.UnwrapFailure(nil, raw, meta.StatusCode, func([]byte) any { return nil })
+		return meta, errutil.InsufficientScope(err, raw)
+	}
+
+	if err := decodeData(raw, out); err != nil {
+		_, apiErr := errutil.UnwrapFailure(err, raw, meta.StatusCode, func([]byte) any { return nil })
+		return meta, apiErr
+	}
+	normalizeNilSlices(reflect.ValueOf(out))
+	return meta, nil
+}
+
+func decodeData[T any](raw []byte, out *T) error {
+	payload := bytes.TrimSpace(raw)
+	if len(payload) == 0 {
+		return nil
+	}
+
+	target := reflect.ValueOf(out).Elem()
+	if payload[0] == '{' && !hasDataField(target.Type()) {
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal(payload, &envelope); err != nil {
+			return err
+		}
+		if data, ok := envelope["data"]; ok {
+			payload = bytes.TrimSpace(data)
+		}
+	}
+	if len(payload) == 0 || bytes.Equal(payload, []byte("null")) {
+		return nil
+	}
+
+	isArray := payload[0] == '['
+
+	switch {
+	case target.Kind() == reflect.Slice && !isArray:
+		elem := reflect.New(target.Type().Elem())
+		if err := json.Unmarshal(payload, elem.Interface()); err != nil {
+			return err
+		}
+		target.Set(reflect.Append(reflect.MakeSlice(target.Type(), 0, 1), elem.Elem()))
+		return nil
+	case target.Kind() != reflect.Slice && isArray:
+		var items []json.RawMessage
+		if err := json.Unmarshal(payload, &items); err != nil {
+			return err
+		}
+		switch len(items) {
+		case 0:
+			return nil
+		case 1:
+			return json.Unmarshal(items[0], out)
+		default:
+			return fmt.Errorf("json: cannot decode array of %d elements into %s", len(items), target.Type())
+		}
+	default:
+		return json.Unmarshal(payload, out)
+	}
+}
+
+// hasDataField reports whether t is a struct that decodes a "data" member
+// itself.
+func hasDataField(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "data" || (name == "" && strings.EqualFold(t.Field(i).Name, "data")) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,38 +5,122 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/extract"
 )
 
+// Parse reads resp with the generated parse function and builds the
+// ResponseMeta shared by all service responses. Only 200 OK is treated as
+// success; use ParseAny or ParseEmpty for endpoints that answer with other
+// 2xx statuses.
+//
+// JSON arrays that the API returned as null, or omitted, are replaced with
+// empty slices in the parsed JSON200 payload, so ranging over response data
+// never needs a nil check.
 func Parse[T any](
 	resp *http.Response,
 	parse func(*http.Response) (*T, error),
 ) (parsed *T, meta ResponseMeta, err error) {
-	raw := extract.Raw(resp)
+	return parseStatus(resp, parse, []int{http.StatusOK}, false)
+}
+
+// ParseAny is Parse for endpoints that succeed with any of codes, such as
+// 201 Created or 202 Accepted. The payload is in the generated JSON<code>
+// field matching the response status, e.g. JSON201, and is normalized like
+// Parse's JSON200. A 204 No Content response needs no payload. With no codes,
+// ParseAny behaves like Parse.
+//
+// Example:
+//
+//	parsed, meta, err := common.ParseAny(resp, v4Client.ParsePostSherlockTasksFlagResponse, http.StatusCreated)
+func ParseAny[T any](
+	resp *http.Response,
+	parse func(*http.Response) (*T, error),
+	codes ...int,
+) (parsed *T, meta ResponseMeta, err error) {
+	if len(codes) == 0 {
+		codes = []int{http.StatusOK}
+	}
+	return parseStatus(resp, parse, codes, false)
+}
+
+// ParseEmpty is for endpoints whose success response carries no payload the
+// caller needs. Any status in codes is a success regardless of the body,
+// which may be empty; with no codes only 204 No Content is. Error statuses
+// are reported exactly as Parse reports them.
+//
+// Example:
+//
+//	meta, err := common.ParseEmpty(resp, v4Client.ParsePostPwnboxTerminateResponse, http.StatusNoContent)
+func ParseEmpty[T any](
+	resp *http.Response,
+	parse func(*http.Response) (*T, error),
+	codes ...int,
+) (meta ResponseMeta, err error) {
+	if len(codes) == 0 {
+		codes = []int{http.StatusNoContent}
+	}
+	_, meta, err = parseStatus(resp, parse, codes, true)
+	return meta, err
+}
 
-	var cfRay string
-	var headers http.Header
-	if resp != nil && resp.Header != nil {
-		cfRay = resp.Header.Get("CF-Ray")
-		headers = resp.Header
+// NewMeta builds the ResponseMeta for resp. Services that read a response
+// without a generated parser use it so their metadata matches Parse's.
+func NewMeta(resp *http.Response, raw []byte, operation string) ResponseMeta {
+	meta := ResponseMeta{
+		Raw:          raw,
+		StatusCode:   -1,
+		Operation:    operation,
+		Attempts:     Attempts(resp),
+		TotalWait:    TotalWait(resp),
+		Deduplicated: Deduplicated(resp),
+	}
+	if resp == nil {
+		return meta
 	}
-	meta = ResponseMeta{
-		Raw:        raw,
-		StatusCode: SafeStatus(resp),
-		Headers:    headers,
-		CFRay:      cfRay,
+	meta.StatusCode = resp.StatusCode
+	if resp.Header != nil {
+		meta.Headers = resp.Header
+		meta.CFRay = resp.Header.Get("CF-Ray")
+		meta.RequestID = resp.Header.Get("X-Request-ID")
+		meta.Warnings = ParseWarnings(resp.Header, operation)
+		reportWarnings(resp, operation, meta.Warnings)
 	}
+	return meta
+}
+
+func parseStatus[T any](
+	resp *http.Response,
+	parse func(*http.Response) (*T, error),
+	codes []int,
+	bodyOptional bool,
+) (parsed *T, meta ResponseMeta, err error) {
+	raw := extract.Raw(resp)
+	meta = NewMeta(resp, raw, operationName[T]())
+	defer func() {
+		var apiErr *errutil.APIError
+		if errors.As(err, &apiErr) && apiErr.Operation == "" {
+			apiErr.Operation = meta.Operation
+		}
+		err = errutil.InsufficientScope(err, raw)
+	}()
 
 	if resp == nil {
 		parsed, err = errutil.UnwrapFailure(errors.New("nil HTTP response"), raw, meta.StatusCode, func([]byte) *T { return nil })
 		return parsed, meta, err
 	}
 
+	success := slices.Contains(codes, resp.StatusCode)
+
 	parsed, err = parse(resp)
 	if err != nil {
+		if success && bodyOptional {
+			return parsed, meta, nil
+		}
 		parsed, err = errutil.UnwrapFailure(err, raw, meta.StatusCode, func([]byte) *T { return nil })
 		return parsed, meta, err
 	}
@@ -50,35 +134,81 @@ func Parse[T any](
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
+	if val.Kind() != reflect.Struct {
+		return parsed, meta, nil
+	}
 
-	if val.Kind() == reflect.Struct {
-		var jsonFound bool
-		for i := 0; i < val.NumField(); i++ {
-			field := val.Type().Field(i)
-			if strings.HasPrefix(field.Name, "JSON") {
-				jsonField := val.Field(i)
-				if jsonField.IsValid() && jsonField.Kind() == reflect.Ptr && !jsonField.IsNil() {
-					jsonFound = true
-
-					if field.Name != "JSON200" {
-						parsed, err = errutil.UnwrapFailure(
-							fmt.Errorf("%+v", jsonField.Interface()),
-							raw,
-							meta.StatusCode,
-							func([]byte) *T { return nil },
-						)
-						return parsed, meta, err
-					}
-					break
-				}
-			}
+	want := "JSON" + strconv.Itoa(resp.StatusCode)
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if !strings.HasPrefix(field.Name, "JSON") {
+			continue
+		}
+		jsonField := val.Field(i)
+		if !jsonField.IsValid() || jsonField.Kind() != reflect.Ptr || jsonField.IsNil() {
+			continue
 		}
 
-		if !jsonFound {
-			parsed, err = errutil.UnwrapFailure(errors.New("no populated JSON* field"), raw, meta.StatusCode, func([]byte) *T { return nil })
+		if !success || field.Name != want {
+			parsed, err = errutil.UnwrapFailure(
+				fmt.Errorf("%+v", jsonField.Interface()),
+				raw,
+				meta.StatusCode,
+				func([]byte) *T { return nil },
+			)
 			return parsed, meta, err
 		}
+		normalizeNilSlices(jsonField)
+		return parsed, meta, nil
 	}
 
-	return parsed, meta, nil
+	if success && (bodyOptional || resp.StatusCode == http.StatusNoContent) {
+		return parsed, meta, nil
+	}
+	if !success {
+		parsed, err = errutil.UnwrapFailure(nil, raw, meta.StatusCode, func([]byte) *T { return nil })
+		return parsed, meta, err
+	}
+	parsed, err = errutil.UnwrapFailure(errors.New("no populated JSON* field"), raw, meta.StatusCode, func([]byte) *T { return nil })
+	return parsed, meta, err
+}
+
+// operationName derives the OpenAPI operation ID from the generated response
+// type, e.g. GetSeasonRewardsResponse -> GetSeasonRewards.
+func operationName[T any]() string {
+	return strings.TrimSuffix(reflect.TypeOf((*T)(nil)).Elem().Name(), "Response")
+}
+
+// normalizeNilSlices walks v and replaces nil slices with empty ones. Byte
+// slices are left alone so raw JSON and binary payloads keep their meaning.
+func normalizeNilSlices(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeNilSlices(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				normalizeNilSlices(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		if v.IsNil() {
+			if v.CanSet() {
+				v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalizeNilSlices(v.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeNilSlices(v.Index(i))
+		}
+	}
 }
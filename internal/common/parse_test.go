@@ -0,0 +1,393 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gubarz/gohtb/internal/errutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	ID   int      `json:"id"`
+	Tags []string `json:"tags"`
+}
+
+type testQueued struct {
+	Message       string `json:"message"`
+	QueuePosition int    `json:"queue_position"`
+}
+
+type testError struct {
+	Message string `json:"message"`
+}
+
+// PostThingResponse mirrors a generated response type with several success
+// payloads.
+type PostThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *testItem
+	JSON201      *testItem
+	JSON202      *testQueued
+	JSON400      *testError
+}
+
+// parsePostThing decodes like the generated parsers: only JSON bodies for
+// documented statuses are unmarshalled, and a bad body is an error.
+func parsePostThing(resp *http.Response) (*PostThingResponse, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	out := &PostThingResponse{Body: body, HTTPResponse: resp}
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return out, nil
+	}
+	switch resp.StatusCode {
+	case 200:
+		err = unmarshalInto(body, &out.JSON200)
+	case 201:
+		err = unmarshalInto(body, &out.JSON201)
+	case 202:
+		err = unmarshalInto(body, &out.JSON202)
+	case 400:
+		err = unmarshalInto(body, &out.JSON400)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func unmarshalInto[T any](body []byte, dst **T) error {
+	var v T
+	if err := json.Unmarshal(body, &v); err != nil {
+		return err
+	}
+	*dst = &v
+	return nil
+}
+
+func testResponse(code int, contentType, body string) *http.Response {
+	resp := &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	if contentType != "" {
+		resp.Header.Set("Content-Type", contentType)
+	}
+	return resp
+}
+
+const jsonType = "application/json"
+
+func TestParseStatusBodyCombinations(t *testing.T) {
+	type parseFn func(*http.Response) (*PostThingResponse, ResponseMeta, error)
+	parse := func(resp *http.Response) (*PostThingResponse, ResponseMeta, error) {
+		return Parse(resp, parsePostThing)
+	}
+	parseAny := func(codes ...int) parseFn {
+		return func(resp *http.Response) (*PostThingResponse, ResponseMeta, error) {
+			return ParseAny(resp, parsePostThing, codes...)
+		}
+	}
+	parseEmpty := func(codes ...int) parseFn {
+		return func(resp *http.Response) (*PostThingResponse, ResponseMeta, error) {
+			meta, err := ParseEmpty(resp, parsePostThing, codes...)
+			return nil, meta, err
+		}
+	}
+
+	tests := []struct {
+		name    string
+		parse   parseFn
+		resp    *http.Response
+		wantErr int // expected APIError status, 0 for success
+		check   func(t *testing.T, parsed *PostThingResponse)
+	}{
+		{
+			name:  "Parse 200 with payload",
+			parse: parse,
+			resp:  testResponse(200, jsonType, `{"id":7}`),
+			check: func(t *testing.T, p *PostThingResponse) {
+				require.NotNil(t, p.JSON200)
+				assert.Equal(t, 7, p.JSON200.ID)
+				assert.Equal(t, []string{}, p.JSON200.Tags, "null arrays become empty slices")
+			},
+		},
+		{
+			name:    "Parse rejects 201",
+			parse:   parse,
+			resp:    testResponse(201, jsonType, `{"id":7}`),
+			wantErr: 201,
+		},
+		{
+			name:    "Parse 400 with error payload",
+			parse:   parse,
+			resp:    testResponse(400, jsonType, `{"message":"bad"}`),
+			wantErr: 400,
+		},
+		{
+			name:  "ParseAny 201 with payload",
+			parse: parseAny(http.StatusCreated),
+			resp:  testResponse(201, jsonType, `{"id":8,"tags":["a"]}`),
+			check: func(t *testing.T, p *PostThingResponse) {
+				require.NotNil(t, p.JSON201)
+				assert.Equal(t, 8, p.JSON201.ID)
+				assert.Equal(t, []string{"a"}, p.JSON201.Tags)
+			},
+		},
+		{
+			name:  "ParseAny 200 among several codes",
+			parse: parseAny(http.StatusOK, http.StatusCreated),
+			resp:  testResponse(200, jsonType, `{"id":9}`),
+			check: func(t *testing.T, p *PostThingResponse) {
+				require.NotNil(t, p.JSON200)
+				assert.Equal(t, 9, p.JSON200.ID)
+			},
+		},
+		{
+			name:  "ParseAny 202 with queue message",
+			parse: parseAny(http.StatusAccepted),
+			resp:  testResponse(202, jsonType, `{"message":"Machine deployment queued","queue_position":3}`),
+			check: func(t *testing.T, p *PostThingResponse) {
+				require.NotNil(t, p.JSON202)
+				assert.Equal(t, "Machine deployment queued", p.JSON202.Message)
+				assert.Equal(t, 3, p.JSON202.QueuePosition)
+			},
+		},
+		{
+			name:    "ParseAny 202 when only 201 succeeds",
+			parse:   parseAny(http.StatusCreated),
+			resp:    testResponse(202, jsonType, `{"message":"queued"}`),
+			wantErr: 202,
+		},
+		{
+			name:    "ParseAny 201 with empty body",
+			parse:   parseAny(http.StatusCreated),
+			resp:    testResponse(201, jsonType, ``),
+			wantErr: 201,
+		},
+		{
+			name:  "ParseAny 204 without body",
+			parse: parseAny(http.StatusNoContent),
+			resp:  testResponse(204, "", ``),
+			check: func(t *testing.T, p *PostThingResponse) {
+				require.NotNil(t, p)
+				assert.Nil(t, p.JSON200)
+			},
+		},
+		{
+			name:    "ParseAny 204 when only 200 succeeds",
+			parse:   parseAny(http.StatusOK),
+			resp:    testResponse(204, "", ``),
+			wantErr: 204,
+		},
+		{
+			name:    "ParseAny without codes rejects 201",
+			parse:   parseAny(),
+			resp:    testResponse(201, jsonType, `{"id":1}`),
+			wantErr: 201,
+		},
+		{
+			name:    "ParseAny 400 with error payload",
+			parse:   parseAny(http.StatusCreated),
+			resp:    testResponse(400, jsonType, `{"message":"bad"}`),
+			wantErr: 400,
+		},
+		{
+			name:  "ParseEmpty 204 by default",
+			parse: parseEmpty(),
+			resp:  testResponse(204, "", ``),
+		},
+		{
+			name:  "ParseEmpty 200 with empty JSON body",
+			parse: parseEmpty(http.StatusOK, http.StatusNoContent),
+			resp:  testResponse(200, jsonType, ``),
+		},
+		{
+			name:  "ParseEmpty 200 with unparseable body",
+			parse: parseEmpty(http.StatusOK),
+			resp:  testResponse(200, jsonType, `not json`),
+		},
+		{
+			name:  "ParseEmpty 202 with queue message",
+			parse: parseEmpty(http.StatusAccepted),
+			resp:  testResponse(202, jsonType, `{"message":"queued"}`),
+		},
+		{
+			name:    "ParseEmpty 200 when only 204 succeeds",
+			parse:   parseEmpty(),
+			resp:    testResponse(200, jsonType, `{"id":1}`),
+			wantErr: 200,
+		},
+		{
+			name:    "ParseEmpty 403",
+			parse:   parseEmpty(http.StatusNoContent),
+			resp:    testResponse(403, jsonType, `{"message":"Forbidden"}`),
+			wantErr: 403,
+		},
+		{
+			name:    "ParseEmpty 500 with unparseable body",
+			parse:   parseEmpty(http.StatusNoContent),
+			resp:    testResponse(500, "text/html", `<html>oops</html>`),
+			wantErr: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.resp.StatusCode
+			parsed, meta, err := tt.parse(tt.resp)
+
+			assert.Equal(t, status, meta.StatusCode)
+			assert.Equal(t, "PostThing", meta.Operation)
+
+			if tt.wantErr != 0 {
+				var apiErr *errutil.APIError
+				require.True(t, errors.As(err, &apiErr), "want an APIError, got %v", err)
+				assert.Equal(t, tt.wantErr, apiErr.StatusCode)
+				assert.Equal(t, "PostThing", apiErr.Operation)
+				return
+			}
+			require.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, parsed)
+			}
+		})
+	}
+}
+
+func TestParseNilResponse(t *testing.T) {
+	_, meta, err := ParseAny(nil, parsePostThing, http.StatusCreated)
+	require.Error(t, err)
+	assert.Equal(t, -1, meta.StatusCode)
+
+	meta, err = ParseEmpty(nil, parsePostThing)
+	require.Error(t, err)
+	assert.Equal(t, -1, meta.StatusCode)
+}
+
+func TestNewMeta(t *testing.T) {
+	resp := testResponse(http.StatusAccepted, jsonType, "")
+	resp.Header.Set("CF-Ray", "abc-AMS")
+	resp.Header.Set("X-Request-ID", "req-1")
+
+	meta := NewMeta(resp, []byte("raw"), "PostThing")
+	assert.Equal(t, http.StatusAccepted, meta.StatusCode)
+	assert.Equal(t, "abc-AMS", meta.CFRay)
+	assert.Equal(t, "req-1", meta.RequestID)
+	assert.Equal(t, "PostThing", meta.Operation)
+	assert.Equal(t, []byte("raw"), meta.Raw)
+
+	meta = NewMeta(nil, nil, "PostThing")
+	assert.Equal(t, -1, meta.StatusCode)
+	assert.Nil(t, meta.Headers)
+}
+
+func TestSafeStatus(t *testing.T) {
+	var nilResp *http.Response
+	var nilWrapper *PostThingResponse
+
+	assert.Equal(t, http.StatusNoContent, SafeStatus(testResponse(http.StatusNoContent, "", "")))
+	assert.Equal(t, -1, SafeStatus(nilResp))
+	assert.Equal(t, -1, SafeStatus(nilWrapper))
+	assert.Equal(t, -1, SafeStatus(nil))
+	assert.Equal(t, http.StatusCreated, SafeStatus(statusWrapper{code: http.StatusCreated}))
+}
+
+type statusWrapper struct{ code int }
+
+func (w statusWrapper) StatusCode() int { return w.code }
+
+// testEnvelope decodes the "data" member itself, like a generated envelope.
+type testEnvelope struct {
+	Data []testItem `json:"data"`
+	Meta struct {
+		Total int `json:"total"`
+	} `json:"meta"`
+}
+
+func TestParseInto(t *testing.T) {
+	decode := func(dst any) func(resp *http.Response) (any, ResponseMeta, error) {
+		return func(resp *http.Response) (any, ResponseMeta, error) {
+			switch out := dst.(type) {
+			case *testItem:
+				meta, err := ParseInto(resp, "GetThing", out)
+				return *out, meta, err
+			case *[]testItem:
+				meta, err := ParseInto(resp, "GetThing", out)
+				return *out, meta, err
+			case *testEnvelope:
+				meta, err := ParseInto(resp, "GetThing", out)
+				return *out, meta, err
+			}
+			panic("unsupported type")
+		}
+	}
+
+	envelope := testEnvelope{Data: []testItem{{ID: 1, Tags: []string{}}}}
+	envelope.Meta.Total = 1
+
+	tests := []struct {
+		name    string
+		into    any
+		body    string
+		want    any
+		wantErr string
+	}{
+		{name: "data object into struct", into: &testItem{}, body: `{"data":{"id":1}}`, want: testItem{ID: 1, Tags: []string{}}},
+		{name: "data object into slice", into: &[]testItem{}, body: `{"data":{"id":1}}`, want: []testItem{{ID: 1, Tags: []string{}}}},
+		{name: "data array into slice", into: &[]testItem{}, body: `{"data":[{"id":1},{"id":2}]}`, want: []testItem{{ID: 1, Tags: []string{}}, {ID: 2, Tags: []string{}}}},
+		{name: "single-element data array into struct", into: &testItem{}, body: `{"data":[{"id":3}]}`, want: testItem{ID: 3, Tags: []string{}}},
+		{name: "empty data array into struct", into: &testItem{}, body: `{"data":[]}`, want: testItem{Tags: []string{}}},
+		{name: "multi-element data array into struct", into: &testItem{}, body: `{"data":[{"id":1},{"id":2}]}`, wantErr: "cannot decode array of 2 elements"},
+		{name: "null data into slice", into: &[]testItem{}, body: `{"data":null}`, want: []testItem{}},
+		{name: "null data into struct", into: &testItem{}, body: `{"data":null}`, want: testItem{Tags: []string{}}},
+		{name: "bare object without data", into: &testItem{}, body: `{"id":4}`, want: testItem{ID: 4, Tags: []string{}}},
+		{name: "bare array", into: &[]testItem{}, body: `[{"id":5}]`, want: []testItem{{ID: 5, Tags: []string{}}}},
+		{name: "empty body", into: &[]testItem{}, body: ``, want: []testItem{}},
+		{name: "envelope type gets the whole body", into: &testEnvelope{}, body: `{"data":[{"id":1}],"meta":{"total":1}}`, want: envelope},
+		{name: "unparseable body", into: &testItem{}, body: `{"data":`, wantErr: "unexpected end of JSON input"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, meta, err := decode(tt.into)(testResponse(http.StatusOK, jsonType, tt.body))
+			assert.Equal(t, "GetThing", meta.Operation)
+			if tt.wantErr != "" {
+				var apiErr *errutil.APIError
+				require.True(t, errors.As(err, &apiErr), "want an APIError, got %v", err)
+				assert.Equal(t, "GetThing", apiErr.Operation)
+				assert.ErrorContains(t, apiErr.Err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseIntoStampsOperation(t *testing.T) {
+	resp := testResponse(http.StatusNotFound, jsonType, `{"message":"Not Found"}`)
+	resp.Header.Set("Deprecation", "true")
+
+	var out testItem
+	meta, err := ParseInto(resp, "GetThing", &out)
+
+	var apiErr *errutil.APIError
+	require.True(t, errors.As(err, &apiErr), "want an APIError, got %v", err)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "GetThing", apiErr.Operation)
+	assert.Equal(t, "GetThing", meta.Operation)
+	require.Len(t, meta.Warnings, 1)
+	assert.Equal(t, "GetThing", meta.Warnings[0].Operation)
+}
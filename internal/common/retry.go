@@ -0,0 +1,90 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RetryStats describes the retry loop's activity for one call.
+type RetryStats struct {
+	Attempts  int
+	TotalWait time.Duration
+}
+
+type retryStatsKey struct{}
+
+// AttachRetryStats records stats on resp so Parse can report them. The
+// stats travel on the context of resp.Request, which the transport owns.
+func AttachRetryStats(resp *http.Response, stats RetryStats) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+	ctx := context.WithValue(resp.Request.Context(), retryStatsKey{}, stats)
+	resp.Request = resp.Request.WithContext(ctx)
+}
+
+// Attempts returns how many attempts produced resp. A response that did not
+// pass through the retrying transport counts as a single attempt.
+func Attempts(resp *http.Response) int {
+	if stats, ok := retryStats(resp); ok {
+		return stats.Attempts
+	}
+	if resp == nil {
+		return 0
+	}
+	return 1
+}
+
+// TotalWait returns the time spent waiting between attempts for resp.
+func TotalWait(resp *http.Response) time.Duration {
+	stats, _ := retryStats(resp)
+	return stats.TotalWait
+}
+
+func retryStats(resp *http.Response) (RetryStats, bool) {
+	if resp == nil || resp.Request == nil {
+		return RetryStats{}, false
+	}
+	stats, ok := resp.Request.Context().Value(retryStatsKey{}).(RetryStats)
+	return stats, ok
+}
+
+type deduplicatedKey struct{}
+
+// MarkDeduplicated records that resp was shared with another identical
+// request rather than fetched for its own.
+func MarkDeduplicated(resp *http.Response) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+	ctx := context.WithValue(resp.Request.Context(), deduplicatedKey{}, true)
+	resp.Request = resp.Request.WithContext(ctx)
+}
+
+// Deduplicated reports whether resp was marked by MarkDeduplicated.
+func Deduplicated(resp *http.Response) bool {
+	if resp == nil || resp.Request == nil {
+		return false
+	}
+	marked, _ := resp.Request.Context().Value(deduplicatedKey{}).(bool)
+	return marked
+}
+
+type unsafeRetryKey struct{}
+
+// WithoutUnsafeRetry marks ctx for a request that must not be sent twice,
+// such as a flag submission. The transport still retries rejections that
+// prove the request was not processed, like 429, but not timeouts, network
+// errors or 5xx responses, after which the request may already have landed.
+func WithoutUnsafeRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unsafeRetryKey{}, true)
+}
+
+// SafeToRetry reports whether req may be retried after resp and err.
+func SafeToRetry(req *http.Request, resp *http.Response, err error) bool {
+	if marked, _ := req.Context().Value(unsafeRetryKey{}).(bool); !marked {
+		return true
+	}
+	return err == nil && resp != nil && resp.StatusCode < http.StatusInternalServerError
+}
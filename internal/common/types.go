@@ -2,6 +2,7 @@ package common
 
 import (
 	"net/http"
+	"time"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 )
@@ -41,6 +42,31 @@ type ResponseMeta struct {
 	StatusCode int
 	Headers    http.Header
 	CFRay      string
+	// RequestID is the X-Request-ID response header, if the API sent one.
+	// Include it in support requests so HTB can correlate the call.
+	RequestID string
+	// QueueWait is how long the call waited for the client's instance lock.
+	// It is only set for instance-mutating calls on a client created with
+	// WithSerializedInstanceOps.
+	QueueWait time.Duration
+	// Operation is the OpenAPI operation ID of the call, e.g. "GetSeasonRewards".
+	// It is stable across calls and safe to use as a metrics label.
+	Operation string
+	// Attempts is how many HTTP attempts the call took, including the first.
+	// It is 1 when no retries occurred.
+	Attempts int
+	// TotalWait is the time spent backing off between retry attempts.
+	TotalWait time.Duration
+	// NotModified is set when a conditional request was answered with
+	// 304 Not Modified. The response then carries no data and the caller's
+	// earlier copy is still current.
+	NotModified bool
+	// Deduplicated is set when the response was shared with an identical
+	// concurrent request on a client created with WithRequestDeduplication.
+	Deduplicated bool
+	// Warnings lists the Deprecation, Sunset and Warning headers of the
+	// response. It is nil when the API sent none.
+	Warnings []Warning
 }
 
 type FlagData struct {
@@ -1,16 +1,25 @@
 package common
 
 import (
+	"net/http"
 	"reflect"
 
 	"github.com/microcosm-cc/bluemonday"
 )
 
+// SafeStatus returns the HTTP status of resp, which may be a raw
+// *http.Response or a generated response wrapper, or -1 when it is nil or
+// carries no status.
 func SafeStatus(resp any) int {
 	switch r := resp.(type) {
+	case *http.Response:
+		if r == nil {
+			return -1
+		}
+		return r.StatusCode
 	case interface{ StatusCode() int }:
 		// Check if underlying value is nil
-		if reflect.ValueOf(r).IsNil() {
+		if v := reflect.ValueOf(r); v.Kind() == reflect.Ptr && v.IsNil() {
 			return -1
 		}
 		return r.StatusCode()
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// WarningKind identifies the header a Warning was read from.
+type WarningKind string
+
+const (
+	// WarningDeprecation comes from a Deprecation header: the endpoint is
+	// deprecated, or will be as of Date.
+	WarningDeprecation WarningKind = "deprecation"
+	// WarningSunset comes from a Sunset header: the endpoint is expected to
+	// stop responding at Date.
+	WarningSunset WarningKind = "sunset"
+	// WarningGeneric comes from a Warning header.
+	WarningGeneric WarningKind = "warning"
+)
+
+// Warning is an advisory header the API sent with a response.
+type Warning struct {
+	Kind WarningKind
+	// Operation is the OpenAPI operation ID of the call that returned it.
+	Operation string
+	// Value is the header value as sent.
+	Value string
+	// Date is the deprecation or sunset date, when the header carries one.
+	Date time.Time
+	// Text is the message of a Warning header, without its code and agent.
+	Text string
+}
+
+// Deprecated reports whether w marks the endpoint as deprecated or sunset.
+func (w Warning) Deprecated() bool {
+	return w.Kind == WarningDeprecation || w.Kind == WarningSunset
+}
+
+// ParseWarnings reads the Deprecation, Sunset and Warning headers of h.
+// Values that cannot be parsed are still reported, with only Value set.
+func ParseWarnings(h http.Header, operation string) []Warning {
+	var out []Warning
+	for _, v := range h.Values("Deprecation") {
+		w := Warning{Kind: WarningDeprecation, Operation: operation, Value: v}
+		w.Date = parseDeprecationDate(v)
+		out = append(out, w)
+	}
+	for _, v := range h.Values("Sunset") {
+		w := Warning{Kind: WarningSunset, Operation: operation, Value: v}
+		if t, err := http.ParseTime(strings.TrimSpace(v)); err == nil {
+			w.Date = t
+		}
+		out = append(out, w)
+	}
+	for _, v := range h.Values("Warning") {
+		out = append(out, Warning{Kind: WarningGeneric, Operation: operation, Value: v, Text: warningText(v)})
+	}
+	return out
+}
+
+// parseDeprecationDate accepts the RFC 9745 form "@<unix seconds>" and the
+// HTTP-date used by earlier drafts. "true" and other values have no date.
+func parseDeprecationDate(v string) time.Time {
+	v = strings.TrimSpace(v)
+	if rest, ok := strings.CutPrefix(v, "@"); ok {
+		if secs, err := strconv.ParseInt(rest, 10, 64); err == nil {
+			return time.Unix(secs, 0).UTC()
+		}
+		return time.Time{}
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// warningText extracts the quoted text of a Warning header value such as
+// `299 - "Deprecated API"`, or returns v unchanged if it has none.
+func warningText(v string) string {
+	start := strings.IndexByte(v, '"')
+	if start < 0 {
+		return strings.TrimSpace(v)
+	}
+	end := strings.IndexByte(v[start+1:], '"')
+	if end < 0 {
+		return strings.TrimSpace(v[start+1:])
+	}
+	return v[start+1 : start+1+end]
+}
+
+// WarningReporter receives the warnings of one call. It is invoked once per
+// parsed response that carried any.
+type WarningReporter func(operation string, warnings []Warning)
+
+type warningReporterKey struct{}
+
+// WithWarningReporter attaches report to ctx so the responses of requests
+// made with it report their warnings to the client.
+func WithWarningReporter(ctx context.Context, report WarningReporter) context.Context {
+	if report == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, warningReporterKey{}, report)
+}
+
+func reportWarnings(resp *http.Response, operation string, warnings []Warning) {
+	if len(warnings) == 0 || resp == nil || resp.Request == nil {
+		return
+	}
+	if report, ok := resp.Request.Context().Value(warningReporterKey{}).(WarningReporter); ok {
+		report(operation, warnings)
+	}
+}
+
+// PrivateMemberWarning returns the warning reported in place of a team
+// member whose activity cannot be read. A nil err means the member's
+// profile is marked private, so their activity was not requested;
+// otherwise err is what the activity request failed with. ok is false when
+// err is not the 403 or 404 a private profile gets, in which case the
+// caller should fail instead of warning.
+func PrivateMemberWarning(name string, id int, err error) (warning string, ok bool) {
+	if err == nil {
+		return fmt.Sprintf("member %s (%d) has a private profile", name, id), true
+	}
+	var apiErr *errutil.APIError
+	if !errors.As(err, &apiErr) {
+		return "", false
+	}
+	if apiErr.StatusCode != http.StatusForbidden && apiErr.StatusCode != http.StatusNotFound {
+		return "", false
+	}
+	return fmt.Sprintf("member %s (%d) activity unavailable: %v", name, id, err), true
+}
@@ -0,0 +1,69 @@
+// Package cursor serializes the position of a pagination iterator so it can
+// be resumed by another process.
+//
+// A cursor is JSON carrying a format version, the operation it was taken
+// from, the filters the iterator was built with and the next page to fetch.
+// Callers treat it as opaque bytes.
+package cursor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Version is the current cursor format. Cursors with any other version are
+// rejected, so a format change can never be misread as the old one.
+const Version = 1
+
+// ErrInvalid is wrapped by every error Decode returns.
+var ErrInvalid = errors.New("invalid cursor")
+
+type envelope struct {
+	Version   int             `json:"v"`
+	Operation string          `json:"op"`
+	Filters   json.RawMessage `json:"filters"`
+	Next      int             `json:"next"`
+}
+
+// Encode returns a cursor for operation, with filters marshalled as JSON and
+// next as the page to fetch on resume.
+func Encode(operation string, filters any, next int) ([]byte, error) {
+	raw, err := json.Marshal(filters)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelope{
+		Version:   Version,
+		Operation: operation,
+		Filters:   raw,
+		Next:      next,
+	})
+}
+
+// Decode reads a cursor made by Encode for operation into filters, which
+// must be a pointer, and returns the page to resume from. It fails if the
+// cursor has another version or operation, if its filters hold fields
+// filters does not have, or if the page is not positive.
+func Decode(data []byte, operation string, filters any) (int, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if env.Version != Version {
+		return 0, fmt.Errorf("%w: format version %d, want %d", ErrInvalid, env.Version, Version)
+	}
+	if env.Operation != operation {
+		return 0, fmt.Errorf("%w: cursor is for %s, not %s", ErrInvalid, env.Operation, operation)
+	}
+	if env.Next < 1 {
+		return 0, fmt.Errorf("%w: page %d", ErrInvalid, env.Next)
+	}
+	dec := json.NewDecoder(bytes.NewReader(env.Filters))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(filters); err != nil {
+		return 0, fmt.Errorf("%w: filters: %v", ErrInvalid, err)
+	}
+	return env.Next, nil
+}
@@ -11,6 +11,8 @@ type APIError struct {
 	Message    string
 	Raw        []byte
 	Err        error
+	// Operation is the OpenAPI operation ID of the failed call, if known.
+	Operation string
 }
 
 const (
@@ -18,10 +20,16 @@ const (
 )
 
 func (e *APIError) Error() string {
+	var msg string
 	if e.Message != "" {
-		return fmt.Sprintf("status %d: %s", e.StatusCode, e.Message)
+		msg = fmt.Sprintf("status %d: %s", e.StatusCode, e.Message)
+	} else {
+		msg = fmt.Sprintf("status %d: %v", e.StatusCode, e.Err)
 	}
-	return fmt.Sprintf("status %d: %v", e.StatusCode, e.Err)
+	if e.Operation != "" {
+		return e.Operation + ": " + msg
+	}
+	return msg
 }
 
 func (e *APIError) Unwrap() error {
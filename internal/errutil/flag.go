@@ -0,0 +1,14 @@
+package errutil
+
+import "fmt"
+
+// ErrMalformedFlag is returned before a flag is submitted when it does not
+// have the shape the target expects.
+type ErrMalformedFlag struct {
+	Flag string
+	Hint string
+}
+
+func (e *ErrMalformedFlag) Error() string {
+	return fmt.Sprintf("malformed flag %q: %s", e.Flag, e.Hint)
+}
@@ -0,0 +1,148 @@
+package errutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrCooldown is returned when an instance operation (spawn, reset, start)
+// is rejected because the account has to wait before trying again.
+type ErrCooldown struct {
+	RetryAfter time.Duration
+	Message    string
+}
+
+func (e *ErrCooldown) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("cooldown active, retry after %v: %s", e.RetryAfter, e.Message)
+	}
+	return fmt.Sprintf("cooldown active: %s", e.Message)
+}
+
+// ErrDailyLimitReached is returned when an instance operation is rejected
+// because a daily quota (resets, spawns, pwnbox time) has been used up.
+type ErrDailyLimitReached struct {
+	ResetsAt time.Time
+	Message  string
+}
+
+func (e *ErrDailyLimitReached) Error() string {
+	if !e.ResetsAt.IsZero() {
+		return fmt.Sprintf("daily limit reached, resets at %s: %s", e.ResetsAt.Format(time.RFC3339), e.Message)
+	}
+	return fmt.Sprintf("daily limit reached: %s", e.Message)
+}
+
+// Known payload shapes returned by the instance endpoints. They are matched
+// case-insensitively against the "message" field of the error body:
+//
+//	{"message":"You must wait 2 minutes before spawning another machine."}
+//	{"message":"Please wait 30 seconds before trying again."}
+//	{"message":"Too many requests. Cooldown: 45s"}
+//	{"message":"You have reached the maximum number of resets for today."}
+//	{"message":"Daily spawn limit reached. Try again in 3 hours."}
+//	{"success":false,"message":"You have used up your daily Pwnbox usage."}
+//
+// A bare "wait" or "today" is not enough: the message must name a wait
+// before retrying, or a limit together with its period, so that unrelated
+// validation errors are left alone.
+var (
+	cooldownPattern = regexp.MustCompile(`(?i)\bcooldown\b|\btoo soon\b` +
+		`|\bwait\s+\d+\s*` + durationUnits + `\b|\bwait\b[^.]*\bbefore\b|\btry again in\s+\d`)
+	dailyLimitPattern = regexp.MustCompile(`(?i)\b(daily|per day)\b[^.]*\b(limit|quota|usage|maximum)\b` +
+		`|\b(limit|quota|maximum)\b[^.]*\b(daily|per day|today|24 hours)\b`)
+	durationPattern = regexp.MustCompile(`(?i)(\d+)\s*` + durationUnits + `\b`)
+)
+
+const durationUnits = `(h|hr|hrs|hours?|m|min|mins|minutes?|s|sec|secs|seconds?)`
+
+// InstanceLimit inspects a failed instance operation and, when the API reports
+// a cooldown or an exhausted daily quota, replaces the wrapped error of the
+// returned *APIError with an *ErrCooldown or *ErrDailyLimitReached so callers
+// can use errors.As instead of matching on message text.
+//
+// Errors that do not match either shape are returned unchanged.
+func InstanceLimit(err error, headers http.Header, raw []byte, now time.Time) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	if apiErr.StatusCode < 400 || apiErr.StatusCode >= 500 {
+		return err
+	}
+
+	msg := messageFromBody(raw)
+	if msg == "" {
+		return err
+	}
+
+	switch {
+	case dailyLimitPattern.MatchString(msg):
+		resetsAt := time.Time{}
+		if d, ok := parseMessageDuration(msg); ok {
+			resetsAt = now.Add(d)
+		} else {
+			y, m, day := now.UTC().Date()
+			resetsAt = time.Date(y, m, day+1, 0, 0, 0, 0, time.UTC)
+		}
+		apiErr.Message = msg
+		apiErr.Err = &ErrDailyLimitReached{ResetsAt: resetsAt, Message: msg}
+	case cooldownPattern.MatchString(msg) || apiErr.StatusCode == http.StatusTooManyRequests:
+		retryAfter, ok := retryAfterHeader(headers, now)
+		if !ok {
+			retryAfter, _ = parseMessageDuration(msg)
+		}
+		apiErr.Message = msg
+		apiErr.Err = &ErrCooldown{RetryAfter: retryAfter, Message: msg}
+	}
+	return err
+}
+
+func messageFromBody(raw []byte) string {
+	var body struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &body) == nil {
+		return strings.TrimSpace(body.Message)
+	}
+	return ""
+}
+
+func retryAfterHeader(headers http.Header, now time.Time) (time.Duration, bool) {
+	v := headers.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now), true
+	}
+	return 0, false
+}
+
+func parseMessageDuration(msg string) (time.Duration, bool) {
+	m := durationPattern.FindStringSubmatch(msg)
+	if len(m) != 3 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	switch unit := strings.ToLower(m[2]); {
+	case strings.HasPrefix(unit, "h"):
+		return time.Duration(n) * time.Hour, true
+	case strings.HasPrefix(unit, "m"):
+		return time.Duration(n) * time.Minute, true
+	default:
+		return time.Duration(n) * time.Second, true
+	}
+}
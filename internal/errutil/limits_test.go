@@ -0,0 +1,113 @@
+package errutil
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceLimit(t *testing.T) {
+	now := time.Date(2025, 3, 4, 15, 0, 0, 0, time.UTC)
+	midnight := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    int
+		headers   http.Header
+		body      string
+		wantCool  *ErrCooldown
+		wantDaily *ErrDailyLimitReached
+	}{
+		// Recorded payloads.
+		{
+			name:     "wait before spawning",
+			status:   400,
+			body:     `{"message":"You must wait 2 minutes before spawning another machine."}`,
+			wantCool: &ErrCooldown{RetryAfter: 2 * time.Minute},
+		},
+		{
+			name:     "wait seconds",
+			status:   400,
+			body:     `{"message":"Please wait 30 seconds before trying again."}`,
+			wantCool: &ErrCooldown{RetryAfter: 30 * time.Second},
+		},
+		{
+			name:     "cooldown with short unit",
+			status:   429,
+			body:     `{"message":"Too many requests. Cooldown: 45s"}`,
+			wantCool: &ErrCooldown{RetryAfter: 45 * time.Second},
+		},
+		{
+			name:     "Retry-After header wins",
+			status:   429,
+			headers:  http.Header{"Retry-After": {"90"}},
+			body:     `{"message":"Too many requests. Cooldown: 45s"}`,
+			wantCool: &ErrCooldown{RetryAfter: 90 * time.Second},
+		},
+		{
+			name:     "429 without a known message",
+			status:   429,
+			body:     `{"message":"Slow down."}`,
+			wantCool: &ErrCooldown{},
+		},
+		{
+			name:      "maximum resets today",
+			status:    400,
+			body:      `{"message":"You have reached the maximum number of resets for today."}`,
+			wantDaily: &ErrDailyLimitReached{ResetsAt: midnight},
+		},
+		{
+			name:      "daily limit with retry",
+			status:    400,
+			body:      `{"message":"Daily spawn limit reached. Try again in 3 hours."}`,
+			wantDaily: &ErrDailyLimitReached{ResetsAt: now.Add(3 * time.Hour)},
+		},
+		{
+			name:      "daily pwnbox usage",
+			status:    403,
+			body:      `{"success":false,"message":"You have used up your daily Pwnbox usage."}`,
+			wantDaily: &ErrDailyLimitReached{ResetsAt: midnight},
+		},
+
+		// Unrelated errors are left alone.
+		{name: "validation error", status: 400, body: `{"message":"The given data was invalid.","errors":{"machine_id":["The machine id field is required."]}}`},
+		{name: "wait for spawn", status: 400, body: `{"message":"Machine is not ready yet, please wait for it to spawn."}`},
+		{name: "try again elsewhere", status: 400, body: `{"message":"This machine is not in your lab, try again in the correct lab."}`},
+		{name: "today without limit", status: 400, body: `{"message":"Today's release has not been published."}`},
+		{name: "daily without limit", status: 400, body: `{"message":"Daily challenges are not available on this server."}`},
+		{name: "24 hours without limit", status: 400, body: `{"message":"Machine was reset in the last 24 hours by another player."}`},
+		{name: "server error", status: 500, body: `{"message":"Please wait 30 seconds before trying again."}`},
+		{name: "no message", status: 400, body: `not json`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cause := errors.New("original")
+			err := InstanceLimit(&APIError{StatusCode: tt.status, Err: cause}, tt.headers, []byte(tt.body), now)
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+
+			var cool *ErrCooldown
+			var daily *ErrDailyLimitReached
+			switch {
+			case tt.wantCool != nil:
+				if assert.True(t, errors.As(err, &cool), "want ErrCooldown, got %v", apiErr.Err) {
+					assert.Equal(t, tt.wantCool.RetryAfter, cool.RetryAfter)
+					assert.Equal(t, messageFromBody([]byte(tt.body)), cool.Message)
+				}
+				assert.False(t, errors.As(err, &daily))
+			case tt.wantDaily != nil:
+				if assert.True(t, errors.As(err, &daily), "want ErrDailyLimitReached, got %v", apiErr.Err) {
+					assert.Equal(t, tt.wantDaily.ResetsAt, daily.ResetsAt)
+				}
+				assert.False(t, errors.As(err, &cool))
+			default:
+				assert.Same(t, cause, apiErr.Err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package errutil
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// ErrInsufficientScope is returned when the API rejects a call because the
+// token was not granted the scope the endpoint needs. Scope is empty when
+// the error body does not name it.
+type ErrInsufficientScope struct {
+	Scope   string
+	Message string
+}
+
+func (e *ErrInsufficientScope) Error() string {
+	if e.Scope != "" {
+		return fmt.Sprintf("token is missing scope %q: %s", e.Scope, e.Message)
+	}
+	return fmt.Sprintf("token scope is insufficient: %s", e.Message)
+}
+
+// Known payload shapes for scope failures:
+//
+//	{"message":"Invalid scope(s) provided."}
+//	{"message":"Missing scope: machines.spawn"}
+//	{"message":"This action requires the scope \"teams.manage\"."}
+var (
+	scopeMentionPattern = regexp.MustCompile(`(?i)\bscopes?\b|\(s\)`)
+	scopeNamePattern    = regexp.MustCompile(`(?i)scope(?:\(s\)|s)?\W+["'“]?([a-z][\w.:*-]*[\w*])`)
+)
+
+// InsufficientScope replaces the wrapped error of a 403 *APIError with an
+// *ErrInsufficientScope when the error body says the token lacks a scope.
+// Other errors are returned unchanged.
+func InsufficientScope(err error, raw []byte) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		return err
+	}
+
+	msg := messageFromBody(raw)
+	if msg == "" || !scopeMentionPattern.MatchString(msg) {
+		return err
+	}
+
+	scope := ""
+	if m := scopeNamePattern.FindStringSubmatch(msg); len(m) == 2 && m[1] != "provided" {
+		scope = m[1]
+	}
+	apiErr.Message = msg
+	apiErr.Err = &ErrInsufficientScope{Scope: scope, Message: msg}
+	return err
+}
@@ -0,0 +1,21 @@
+package errutil
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+// Transient reports whether err is worth retrying: a network failure or
+// timeout, a rate limit, or a server error.
+func Transient(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		var opErr *net.OpError
+		var netErr net.Error
+		return errors.As(err, &opErr) || (errors.As(err, &netErr) && netErr.Timeout())
+	}
+	return apiErr.StatusCode == 0 ||
+		apiErr.StatusCode == http.StatusTooManyRequests ||
+		apiErr.StatusCode >= http.StatusInternalServerError
+}
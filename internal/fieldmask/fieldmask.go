@@ -0,0 +1,87 @@
+// Package fieldmask strips unwanted fields from decoded list items.
+//
+// The HTB API has no sparse fieldset parameter, so projection always happens
+// client-side after the full page has been decoded.
+package fieldmask
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Prune zeroes every exported field of each struct in items that is not
+// named in fields. Names match either the JSON key or the Go field name,
+// ignoring case. Fields of embedded structs are treated as fields of the
+// outer struct. A name that matches no field is an error, so typos do not
+// silently empty the result.
+func Prune[T any](items []T, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	keep := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		keep[strings.ToLower(f)] = true
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("fieldmask: %s is not a struct", t)
+	}
+	known := map[string]bool{}
+	collect(t, known)
+	for _, f := range fields {
+		if !known[strings.ToLower(f)] {
+			return fmt.Errorf("fieldmask: unknown field %q for %s", f, t.Name())
+		}
+	}
+
+	for i := range items {
+		prune(reflect.ValueOf(&items[i]).Elem(), keep)
+	}
+	return nil
+}
+
+func collect(t reflect.Type, known map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			collect(f.Type, known)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		known[strings.ToLower(f.Name)] = true
+		if name := jsonName(f); name != "" {
+			known[strings.ToLower(name)] = true
+		}
+	}
+}
+
+func prune(v reflect.Value, keep map[string]bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			prune(v.Field(i), keep)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if keep[strings.ToLower(f.Name)] || keep[strings.ToLower(jsonName(f))] {
+			continue
+		}
+		v.Field(i).SetZero()
+	}
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,198 @@
+package flagutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// Outcome is what happened to one flag in a bulk submission.
+type Outcome string
+
+const (
+	// Accepted means the flag was correct and newly owned.
+	Accepted Outcome = "accepted"
+	// AlreadyOwned means the flag was correct but had been submitted before.
+	AlreadyOwned Outcome = "already owned"
+	// Incorrect means the flag was rejected as wrong or malformed.
+	Incorrect Outcome = "incorrect"
+	// Failed means the submission could not be completed, for example
+	// because of an auth or network error. Err holds the cause.
+	Failed Outcome = "error"
+	// Skipped means the flag was not submitted because the run stopped
+	// early.
+	Skipped Outcome = "skipped"
+)
+
+// Result is the outcome of submitting one flag. Index is the flag's position
+// in the input.
+type Result struct {
+	Index   int
+	Flag    string
+	Outcome Outcome
+	Message string
+	Err     error
+}
+
+// Results holds one Result per submitted flag, in input order.
+type Results []Result
+
+// Count returns how many results have the given outcome.
+func (r Results) Count(outcome Outcome) int {
+	n := 0
+	for _, res := range r {
+		if res.Outcome == outcome {
+			n++
+		}
+	}
+	return n
+}
+
+// BulkOptions controls a bulk submission. Use the option functions rather
+// than setting fields directly.
+type BulkOptions struct {
+	stopOnFirstError bool
+}
+
+// BulkOption configures a bulk submission.
+type BulkOption func(*BulkOptions)
+
+// WithStopOnFirstError stops a bulk submission at the first flag that is not
+// accepted or already owned, instead of only on auth errors.
+func WithStopOnFirstError() BulkOption {
+	return func(o *BulkOptions) {
+		o.stopOnFirstError = true
+	}
+}
+
+// SubmitAll submits flags one after another with submit and classifies each
+// reply. By default it continues past incorrect flags and other failures and
+// stops only on auth errors or context cancellation; WithStopOnFirstError
+// stops at the first flag that did not land. Flags after a stop are reported
+// as Skipped.
+//
+// The returned error is the cause of an early stop due to a failure, or nil.
+func SubmitAll(
+	ctx context.Context,
+	flags []string,
+	submit func(ctx context.Context, flag string) (message string, err error),
+	opts ...BulkOption,
+) (Results, error) {
+	var o BulkOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	results := make(Results, len(flags))
+	var stopErr error
+	stopped := false
+	for i, flag := range flags {
+		results[i] = Result{Index: i, Flag: flag}
+		if stopped {
+			results[i].Outcome = Skipped
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			results[i].Outcome = Skipped
+			stopped, stopErr = true, err
+			continue
+		}
+
+		msg, err := submit(ctx, flag)
+		results[i].Outcome, results[i].Message = classify(msg, err)
+		if results[i].Outcome == Failed || results[i].Outcome == Incorrect {
+			results[i].Err = err
+		}
+
+		switch {
+		case results[i].Outcome == Failed && (isAuthError(err) || ctx.Err() != nil):
+			stopped, stopErr = true, err
+		case o.stopOnFirstError && results[i].Outcome == Failed:
+			stopped, stopErr = true, err
+		case o.stopOnFirstError && results[i].Outcome == Incorrect:
+			stopped = true
+		}
+	}
+	return results, stopErr
+}
+
+func classify(msg string, err error) (Outcome, string) {
+	if err == nil {
+		switch {
+		case isAlreadyOwned(msg):
+			return AlreadyOwned, msg
+		case isIncorrect(msg):
+			return Incorrect, msg
+		}
+		return Accepted, msg
+	}
+
+	var malformed *errutil.ErrMalformedFlag
+	if errors.As(err, &malformed) {
+		return Incorrect, malformed.Error()
+	}
+
+	var apiErr *errutil.APIError
+	if !errors.As(err, &apiErr) {
+		return Failed, err.Error()
+	}
+	body := bodyMessage(apiErr.Raw)
+	if body == "" {
+		body = apiErr.Message
+	}
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity:
+		if isAlreadyOwned(body) {
+			return AlreadyOwned, body
+		}
+		return Incorrect, body
+	}
+	return Failed, body
+}
+
+func isAuthError(err error) bool {
+	var apiErr *errutil.APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+func isAlreadyOwned(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "already owned") || strings.Contains(msg, "already submitted")
+}
+
+// isIncorrect catches rejections that are reported with a success status.
+func isIncorrect(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "incorrect") || strings.Contains(msg, "wrong flag") || strings.Contains(msg, "invalid flag")
+}
+
+func bodyMessage(raw []byte) string {
+	var body struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &body) == nil {
+		return strings.TrimSpace(body.Message)
+	}
+	return ""
+}
+
+// Uncertain reports whether a submission that failed with err may still
+// have been recorded: the request timed out, the connection failed, or the
+// server errored after receiving it. A cancelled ctx is never uncertain.
+func Uncertain(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var apiErr *errutil.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 0 || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
@@ -0,0 +1,67 @@
+// Package flagutil cleans up pasted flags and checks their shape before they are
+// submitted.
+package flagutil
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// Kind is the flag format a target expects.
+type Kind int
+
+const (
+	// Hash is a bare 32 character hex string, used by machines.
+	Hash Kind = iota
+	// Wrapped is an HTB{...} flag, used by challenges.
+	Wrapped
+	// Prefixed is a flag wrapped in any alphanumeric prefix, such as
+	// HTB{...} or DANTE{...}, used by fortresses and prolabs.
+	Prefixed
+	// Answer is free text, used by sherlock tasks. Only surrounding
+	// whitespace is removed.
+	Answer
+)
+
+var (
+	hashPattern     = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+	wrappedPattern  = regexp.MustCompile(`^HTB\{.+\}$`)
+	prefixedPattern = regexp.MustCompile(`^[A-Za-z0-9_]+\{.+\}$`)
+)
+
+// Normalize trims whitespace and surrounding quotes from raw and validates it
+// for kind. Machine flags pasted as HTB{<hash>} are unwrapped.
+//
+// If c is a client created with raw flags enabled, raw is returned unchanged.
+func Normalize(c any, raw string, kind Kind) (string, error) {
+	if p, ok := c.(interface{ RawFlags() bool }); ok && p.RawFlags() {
+		return raw, nil
+	}
+
+	f := strings.TrimSpace(raw)
+	if kind == Answer {
+		return f, nil
+	}
+	f = strings.TrimSpace(strings.Trim(f, "\"'`"))
+
+	switch kind {
+	case Hash:
+		if wrappedPattern.MatchString(f) {
+			f = f[len("HTB{") : len(f)-1]
+		}
+		if !hashPattern.MatchString(f) {
+			return "", &errutil.ErrMalformedFlag{Flag: raw, Hint: "machine flags are 32 hex characters, e.g. 60b725f10c9c85c70d97880dfe8191b3"}
+		}
+	case Wrapped:
+		if !wrappedPattern.MatchString(f) {
+			return "", &errutil.ErrMalformedFlag{Flag: raw, Hint: "expected a flag in the form HTB{...}"}
+		}
+	case Prefixed:
+		if !prefixedPattern.MatchString(f) {
+			return "", &errutil.ErrMalformedFlag{Flag: raw, Hint: "expected a flag in the form PREFIX{...}, e.g. HTB{...}"}
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,35 @@
+// Package poll computes the wait between polls of watch helpers.
+package poll
+
+import "time"
+
+// Interval yields the wait before the next poll. With Min equal to Max it
+// is a fixed interval; otherwise it doubles after every poll that saw no
+// change, up to Max, and drops back to Min after one that did.
+type Interval struct {
+	Min, Max time.Duration
+	cur      time.Duration
+}
+
+// Fixed returns an Interval that always waits d.
+func Fixed(d time.Duration) *Interval {
+	return &Interval{Min: d, Max: d}
+}
+
+// Backoff returns an Interval growing from lo to hi. hi is raised to lo if
+// it is smaller.
+func Backoff(lo, hi time.Duration) *Interval {
+	return &Interval{Min: lo, Max: max(hi, lo)}
+}
+
+// Next returns the wait after a poll; changed reports whether the poll saw
+// anything new.
+func (i *Interval) Next(changed bool) time.Duration {
+	switch {
+	case changed || i.cur == 0:
+		i.cur = i.Min
+	default:
+		i.cur = min(i.cur*2, i.Max)
+	}
+	return i.cur
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+// InstanceLocker is implemented by clients that serialize instance-mutating
+// calls (spawn, stop, reset, extend). Clients that do not implement it, or
+// return a nil unlock, are not serialized.
+type InstanceLocker interface {
+	LockInstance(ctx context.Context) (unlock func(), err error)
+}
+
+// LockInstance acquires the client's instance lock, if it has one, and
+// returns how long the caller waited for it. The returned unlock is never nil.
+func LockInstance(ctx context.Context, c Client) (unlock func(), wait time.Duration, err error) {
+	l, ok := c.(InstanceLocker)
+	if !ok {
+		return func() {}, 0, nil
+	}
+
+	clk := clock.From(c)
+	start := clk.Now()
+	unlock, err = l.LockInstance(ctx)
+	wait = clock.Since(clk, start)
+	if err != nil {
+		return func() {}, wait, err
+	}
+	if unlock == nil {
+		unlock = func() {}
+	}
+	return unlock, wait, nil
+}
@@ -0,0 +1,59 @@
+// Package stream copies download bodies to a writer while reporting progress.
+package stream
+
+import (
+	"io"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+const (
+	chunkSize        = 32 * 1024
+	progressBytes    = 256 * 1024
+	progressInterval = 200 * time.Millisecond
+)
+
+// Copy copies src to dst and calls progress with the number of bytes written
+// so far and total. total is -1 when the size is unknown.
+//
+// progress is called at most once per 256 KiB or 200ms, whichever comes
+// first, and always once more when the copy ends, with the interval measured
+// on clk. A nil progress is allowed.
+func Copy(clk clock.Clock, dst io.Writer, src io.Reader, total int64, progress func(downloaded, total int64)) (int64, error) {
+	if progress == nil {
+		return io.Copy(dst, src)
+	}
+
+	buf := make([]byte, chunkSize)
+	var written, reported int64
+	last := clk.Now()
+
+	for {
+		n, rerr := src.Read(buf)
+		if n > 0 {
+			m, werr := dst.Write(buf[:n])
+			written += int64(m)
+			if werr == nil && m != n {
+				werr = io.ErrShortWrite
+			}
+			if werr != nil {
+				progress(written, total)
+				return written, werr
+			}
+			if written-reported >= progressBytes || clock.Since(clk, last) >= progressInterval {
+				progress(written, total)
+				reported = written
+				last = clk.Now()
+			}
+		}
+		if rerr == io.EOF {
+			progress(written, total)
+			return written, nil
+		}
+		if rerr != nil {
+			progress(written, total)
+			return written, rerr
+		}
+	}
+}
@@ -13,6 +13,9 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
 )
 
 const (
@@ -38,6 +41,11 @@ type RateLimiter struct {
 	pauseUntil time.Time
 	ctx        context.Context
 	logger     Logger
+	clock      clock.Clock
+	events     *eventBus
+	// refill is how often a token is added back when the API sends no rate
+	// limit headers.
+	refill time.Duration
 }
 
 type RateLimitInfo struct {
@@ -51,13 +59,39 @@ type APITransport struct {
 	limiter     *RateLimiter
 	retryConfig RetryConfig
 	logger      Logger
+	clock       clock.Clock
+	events      *eventBus
 }
 
 func NewRateLimiter(ctx context.Context, logger Logger) *RateLimiter {
 	if logger == nil {
 		logger = NoopLogger{}
 	}
-	return &RateLimiter{ctx: ctx, logger: logger, limit: RateLimitInfo{Remaining: defaultRateLimitBurst, Limit: defaultRateLimitBurst}}
+	return &RateLimiter{ctx: ctx, logger: logger, clock: clock.Real{}, refill: defaultRefillInterval, limit: RateLimitInfo{Remaining: defaultRateLimitBurst, Limit: defaultRateLimitBurst}}
+}
+
+// WithRateLimit sets the client-side request budget used when the API does
+// not send rate limit headers: up to burst requests at once, with one more
+// allowed every refill. Values of zero or less keep the defaults of 10 and
+// 250ms. Server-provided headers still take precedence once seen.
+func WithRateLimit(burst int, refill time.Duration) Option {
+	return func(c *Client) {
+		c.rateBurst = burst
+		c.rateRefill = refill
+	}
+}
+
+// newRateLimiter builds a rate limiter with the client's clock and budget.
+func (c *Client) newRateLimiter() *RateLimiter {
+	r := NewRateLimiter(context.Background(), c.logger)
+	r.clock = c.clock
+	if c.rateBurst > 0 {
+		r.limit = RateLimitInfo{Remaining: c.rateBurst, Limit: c.rateBurst}
+	}
+	if c.rateRefill > 0 {
+		r.refill = c.rateRefill
+	}
+	return r
 }
 
 func NewAPITransport(underlying http.RoundTripper, limiter *RateLimiter, retryConfig RetryConfig, logger Logger) *APITransport {
@@ -80,6 +114,7 @@ func NewAPITransport(underlying http.RoundTripper, limiter *RateLimiter, retryCo
 		limiter:     limiter,
 		retryConfig: retryConfig,
 		logger:      logger,
+		clock:       clock.Real{},
 	}
 }
 
@@ -87,15 +122,16 @@ func (r *RateLimiter) BeforeRequest() error {
 	r.mu.Lock()
 
 	for {
-		now := time.Now()
+		now := r.clock.Now()
 
 		// If a CloudFlare backoff is active, wait until it expires before
 		// proceeding. This blocks ALL goroutines, not just the one that
 		// received the 429.
 		if !r.pauseUntil.IsZero() {
 			if now.Before(r.pauseUntil) {
-				wait := time.Until(r.pauseUntil)
+				wait := r.pauseUntil.Sub(now)
 				r.logger.Debug("CloudFlare backoff active, waiting %v", wait)
+				r.events.emit(Event{Kind: EventThrottled, Time: now, Wait: wait})
 				r.mu.Unlock()
 				if err := r.sleep(wait); err != nil {
 					return err
@@ -118,7 +154,7 @@ func (r *RateLimiter) BeforeRequest() error {
 		// as a gentle supplement that gets corrected immediately.
 		if !r.lastRefill.IsZero() {
 			elapsed := now.Sub(r.lastRefill)
-			newTokens := int(elapsed / defaultRefillInterval)
+			newTokens := int(elapsed / r.refill)
 			if newTokens > 0 {
 				r.limit.Remaining += newTokens
 				if r.limit.Remaining > r.limit.Limit {
@@ -126,7 +162,7 @@ func (r *RateLimiter) BeforeRequest() error {
 				}
 				// Advance by consumed intervals (not to now) to preserve
 				// fractional time for the next refill calculation.
-				r.lastRefill = r.lastRefill.Add(time.Duration(newTokens) * defaultRefillInterval)
+				r.lastRefill = r.lastRefill.Add(time.Duration(newTokens) * r.refill)
 			}
 		} else {
 			r.lastRefill = now
@@ -137,9 +173,10 @@ func (r *RateLimiter) BeforeRequest() error {
 		}
 
 		// Budget exhausted. Wait for the next token to become available.
-		r.logger.Debug("Rate limit budget exhausted (0/%d), waiting %v for next token", r.limit.Limit, defaultRefillInterval)
+		r.logger.Debug("Rate limit budget exhausted (0/%d), waiting %v for next token", r.limit.Limit, r.refill)
+		r.events.emit(Event{Kind: EventThrottled, Time: now, Wait: r.refill})
 		r.mu.Unlock()
-		if err := r.sleep(defaultRefillInterval); err != nil {
+		if err := r.sleep(r.refill); err != nil {
 			return err
 		}
 		r.mu.Lock()
@@ -153,6 +190,12 @@ func (r *RateLimiter) BeforeRequest() error {
 }
 
 func (r *RateLimiter) AfterResponse(resp *http.Response) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		e := requestEvent(EventRateLimited, r.clock.Now(), resp.Request)
+		e.StatusCode = resp.StatusCode
+		r.events.emit(e)
+	}
+
 	// Detect CloudFlare 429s: these arrive without Retry-After or rate limit
 	// headers. Enforce a hard 10s global backoff so every goroutine pauses,
 	// not just the one that received the 429.
@@ -160,7 +203,7 @@ func (r *RateLimiter) AfterResponse(resp *http.Response) {
 		strings.Contains(strings.ToLower(resp.Header.Get("Server")), "cloudflare") {
 		r.mu.Lock()
 		backoff := 10 * time.Second
-		r.pauseUntil = time.Now().Add(backoff)
+		r.pauseUntil = r.clock.Now().Add(backoff)
 		r.limit.Remaining = 0
 		r.logger.Info("CloudFlare 429 detected, global backoff for %v", backoff)
 		r.mu.Unlock()
@@ -182,7 +225,7 @@ func (r *RateLimiter) AfterResponse(resp *http.Response) {
 		r.limit = RateLimitInfo{Remaining: remain, Limit: limit, Reset: reset}
 		// Reset the refill baseline so the time-based refill doesn't
 		// immediately add phantom tokens on top of the server's value.
-		r.lastRefill = time.Now()
+		r.lastRefill = r.clock.Now()
 		r.logger.Debug("Rate limit updated from headers — remaining: %d, limit: %d, reset: %v", remain, limit, reset)
 	} else {
 		// No rate limit headers returned. The time-based refill in
@@ -223,16 +266,57 @@ func (r *RateLimiter) Wrap(userCtx context.Context) context.Context {
 	return ctx
 }
 
-func (r *RateLimiter) sleep(d time.Duration) error {
-	timer := time.NewTimer(d)
-	defer timer.Stop()
-
-	select {
-	case <-r.ctx.Done():
-		return r.ctx.Err()
-	case <-timer.C:
-		return nil
+// NextAvailable reports when BeforeRequest would next let a request through
+// without waiting. It returns the current time when a token is available now.
+// The answer is a snapshot: concurrent callers may take the token first.
+func (r *RateLimiter) NextAvailable() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	if !r.pauseUntil.IsZero() {
+		if now.Before(r.pauseUntil) {
+			return r.pauseUntil
+		}
+		// An expired pause refills the whole budget on the next request.
+		return now
 	}
+	if r.limit.Remaining > 0 || r.lastRefill.IsZero() {
+		return now
+	}
+
+	// Mirror the refill in BeforeRequest: one token per interval since the
+	// last refill.
+	next := r.lastRefill.Add(r.refill)
+	if !next.After(now) {
+		return now
+	}
+	return next
+}
+
+// NextAvailable reports when a request to endpoint could next be sent
+// without waiting on the rate limiter, so schedulers can sleep until then
+// instead of polling.
+//
+// All endpoints currently draw from the client's single global bucket, so
+// endpoint does not change the answer; it is accepted so callers need not
+// change if per-endpoint buckets are added. Clients derived with
+// WithSharedLimiter report the shared bucket.
+//
+// Example:
+//
+//	if wait := time.Until(client.NextAvailable("/machine/paginated")); wait > 0 {
+//		time.Sleep(wait)
+//	}
+func (c *Client) NextAvailable(endpoint string) time.Time {
+	if c.rateLimiter == nil {
+		return c.clock.Now()
+	}
+	return c.rateLimiter.NextAvailable()
+}
+
+func (r *RateLimiter) sleep(d time.Duration) error {
+	return clock.Sleep(r.ctx, r.clock, d)
 }
 
 // DefaultRetryPolicy provides a basic retry strategy.
@@ -307,6 +391,11 @@ func (p *DefaultRetryPolicy) Wait(retries int) time.Duration {
 	return waitDuration
 }
 
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *APITransport) CloseIdleConnections() {
+	closeIdleConnections(t.underlying)
+}
+
 func (t *APITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var err error
@@ -323,7 +412,13 @@ func (t *APITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.Body.Close()
 	}
 
-	for retries := 0; ; retries++ {
+	var totalWait time.Duration
+	retries := 0
+	defer func() {
+		common.AttachRetryStats(resp, common.RetryStats{Attempts: retries + 1, TotalWait: totalWait})
+	}()
+
+	for ; ; retries++ {
 		// --- Rate Limiter Check ---
 		// Check rate limit *before* each attempt.
 		if err := t.limiter.BeforeRequest(); err != nil {
@@ -358,7 +453,8 @@ func (t *APITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		// Use the latest response and error for the retry decision.
 		resp = currentResp
 		err = currentErr
-		shouldRetry := t.retryConfig.RetryPolicy.ShouldRetry(resp, err)
+		shouldRetry := t.retryConfig.RetryPolicy.ShouldRetry(resp, err) &&
+			common.SafeToRetry(req, resp, err)
 
 		// --- Decide to Break or Continue ---
 		if !shouldRetry || retries >= t.retryConfig.MaxRetries {
@@ -396,6 +492,15 @@ func (t *APITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 				return 0
 			}())
 
+		e := requestEvent(EventRetry, t.clock.Now(), req)
+		e.Attempt = retries + 1
+		e.Wait = waitTime
+		e.Err = err
+		if resp != nil {
+			e.StatusCode = resp.StatusCode
+		}
+		t.events.emit(e)
+
 		select {
 		case <-req.Context().Done():
 			t.logger.Warn("Request context cancelled during retry wait", "error", req.Context().Err())
@@ -405,8 +510,9 @@ func (t *APITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 				err = req.Context().Err()
 			}
 			return resp, err // Return last known state + context error
-		case <-time.After(waitTime):
+		case <-t.clock.After(waitTime):
 			// Continue to the next iteration after waiting.
+			totalWait += waitTime
 		}
 	}
 
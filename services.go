@@ -5,6 +5,8 @@ import (
 
 	v4client "github.com/gubarz/gohtb/httpclient/v4"
 	v5client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
 	"github.com/gubarz/gohtb/internal/logging"
 )
 
@@ -30,9 +32,40 @@ func (a *serviceAdapter) V5() v5client.ClientInterface {
 func (a *serviceAdapter) Limiter() interface {
 	Wrap(context.Context) context.Context
 } {
-	return a.client.rateLimiter
+	return identityLimiter{limiter: a.client.rateLimiter, identity: a.client.identity, report: a.client.reportWarnings}
+}
+
+// identityLimiter labels request contexts with the client's identity so
+// events can tell derived clients apart, and with the client's warning
+// reporter so parsed responses can report their warning headers.
+type identityLimiter struct {
+	limiter  *RateLimiter
+	identity string
+	report   common.WarningReporter
+}
+
+func (l identityLimiter) Wrap(ctx context.Context) context.Context {
+	ctx = common.WithWarningReporter(l.limiter.Wrap(ctx), l.report)
+	return withIdentity(ctx, l.identity)
 }
 
 func (a *serviceAdapter) Logger() logging.Logger {
 	return a.client.logger
 }
+
+// LockInstance serializes instance-mutating calls when the client was created
+// with WithSerializedInstanceOps.
+func (a *serviceAdapter) LockInstance(ctx context.Context) (func(), error) {
+	if a.client.instanceLock == nil {
+		return nil, nil
+	}
+	return a.client.instanceLock.lock(ctx)
+}
+
+func (a *serviceAdapter) Clock() clock.Clock {
+	return a.client.clock
+}
+
+func (a *serviceAdapter) RawFlags() bool {
+	return a.client.rawFlags
+}
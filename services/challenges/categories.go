@@ -0,0 +1,78 @@
+package challenges
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// categoryCountsTTL is how long a CategoryCounts result is reused.
+const categoryCountsTTL = time.Minute
+
+type categoryCountsEntry struct {
+	counts    map[string]int
+	meta      common.ResponseMeta
+	fetchedAt time.Time
+}
+
+type CategoryCountsResponse struct {
+	// Data maps each category name to its number of active challenges.
+	// Categories without active challenges are included with a count of
+	// zero.
+	Data         map[string]int
+	ResponseMeta common.ResponseMeta
+}
+
+// CategoryCounts returns the number of active challenges in each category.
+// It fetches the category list and walks the active challenge list once,
+// counting by category. The result is kept on the service for a minute, so
+// repeated calls, such as from a category picker, make no further requests
+// until it expires; the ResponseMeta is then that of the original call.
+//
+// Example:
+//
+//	counts, err := client.Challenges.CategoryCounts(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for name, n := range counts.Data {
+//		fmt.Printf("%s (%d)\n", name, n)
+//	}
+func (s *Service) CategoryCounts(ctx context.Context) (CategoryCountsResponse, error) {
+	now := clock.From(s.base.Client).Now()
+	if e := s.categoryCounts.Load(); e != nil && now.Sub(e.fetchedAt) < categoryCountsTTL {
+		return CategoryCountsResponse{Data: maps.Clone(e.counts), ResponseMeta: e.meta}, nil
+	}
+
+	categories, err := s.Categories(ctx)
+	if err != nil {
+		return CategoryCountsResponse{ResponseMeta: categories.ResponseMeta}, err
+	}
+	names := make(map[int]string, len(categories.Data))
+	counts := make(map[string]int, len(categories.Data))
+	for _, c := range categories.Data {
+		names[c.Id] = c.Name
+		counts[c.Name] = 0
+	}
+
+	list, err := s.List().ByState("active").AllResults(ctx)
+	if err != nil {
+		return CategoryCountsResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	for _, c := range list.Data {
+		name, ok := names[c.CategoryId]
+		if !ok {
+			name = c.CategoryName
+		}
+		counts[name]++
+	}
+
+	s.categoryCounts.Store(&categoryCountsEntry{counts: counts, meta: list.ResponseMeta, fetchedAt: now})
+	return CategoryCountsResponse{
+		Data:         maps.Clone(counts),
+		ResponseMeta: list.ResponseMeta,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package challenges_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/gubarz/gohtb/services/challenges"
+)
+
+func ExampleHandle_Info() {
+	srv := gohtbtest.NewServer().
+		JSON("GetChallengeInfo", `{"challenge":{"id":196,"name":"Weak RSA","difficulty":"Easy","category_name":"Crypto"}}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	info, err := client.Challenges.Challenge(196).Info(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Challenge: %s (%s, %s)\n", info.Data.Name, info.Data.Difficulty, info.Data.CategoryName)
+	// Output:
+	// Challenge: Weak RSA (Easy, Crypto)
+}
+
+func ExampleHandle_Own() {
+	srv := gohtbtest.NewServer().
+		JSON("PostChallengeOwn", `{"message":"Congratulations! You have solved Weak RSA."}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := client.Challenges.Challenge(196).Own(context.Background(), "HTB{example_flag_here}", 10)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(result.Data.Message)
+	// Output:
+	// Congratulations! You have solved Weak RSA.
+}
+
+func ExampleChallengeQuery_Next() {
+	names := []string{"Weak RSA", "Lernaean", "Templated", "Emdee five for life", "Baby RE"}
+	srv := gohtbtest.NewServer().
+		HandleFunc("GetChallenges", func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+			var rows []string
+			for i := (page - 1) * perPage; i < min(page*perPage, len(names)); i++ {
+				rows = append(rows, fmt.Sprintf(`{"id":%d,"name":%q}`, i+1, names[i]))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(rows, ","))
+		})
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query := client.Challenges.List().ByState(challenges.StateRetired).PerPage(2)
+	for page := 1; ; page++ {
+		resp, err := query.Results(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, c := range resp.Data {
+			fmt.Printf("page %d: %s\n", page, c.Name)
+		}
+		if len(resp.Data) < 2 {
+			break
+		}
+		query = query.Next()
+	}
+	// Output:
+	// page 1: Weak RSA
+	// page 1: Lernaean
+	// page 2: Templated
+	// page 2: Emdee five for life
+	// page 3: Baby RE
+}
@@ -0,0 +1,85 @@
+package challenges
+
+import (
+	"context"
+	"time"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+const maxNewChallenges = 50
+
+// NewChallenge is a recently added challenge together with its author.
+type NewChallenge struct {
+	ChallengeList
+	AddedAt    time.Time
+	AuthorID   int
+	AuthorName string
+}
+
+type NewChallengesResponse struct {
+	Data         []NewChallenge
+	ResponseMeta common.ResponseMeta
+}
+
+// New retrieves challenges released after since, newest first.
+// limit is capped at 50; zero or a negative value returns the maximum.
+// If since is the zero time, the most recently released challenges are returned.
+//
+// The challenge list does not include authors, so one info request is made
+// per returned challenge, with bounded parallelism.
+//
+// Example:
+//
+//	added, err := client.Challenges.New(ctx, time.Now().AddDate(0, 0, -7), 20)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, c := range added.Data {
+//		fmt.Printf("%s by %s (%s)\n", c.Name, c.AuthorName, c.AddedAt.Format("2006-01-02"))
+//	}
+func (s *Service) New(ctx context.Context, since time.Time, limit int) (NewChallengesResponse, error) {
+	if limit <= 0 || limit > maxNewChallenges {
+		limit = maxNewChallenges
+	}
+
+	resp, err := s.List().
+		sort(v4Client.GetChallengesParamsSortByReleaseDate, v4Client.GetChallengesParamsSortType("desc")).
+		PerPage(limit).
+		Results(ctx)
+	if err != nil {
+		return NewChallengesResponse{ResponseMeta: resp.ResponseMeta}, err
+	}
+
+	items := make([]NewChallenge, 0, len(resp.Data))
+	for _, c := range resp.Data {
+		if !since.IsZero() && !c.ReleaseDate.After(since) {
+			break
+		}
+		items = append(items, NewChallenge{ChallengeList: c, AddedAt: c.ReleaseDate})
+		if len(items) == limit {
+			break
+		}
+	}
+
+	infos, err := batch.Collect(ctx, len(items), batch.DefaultConcurrency, func(ctx context.Context, i int) (InfoResponse, error) {
+		return s.Challenge(items[i].Id).Info(ctx)
+	})
+	if err != nil {
+		return NewChallengesResponse{ResponseMeta: resp.ResponseMeta}, err
+	}
+	if err := infos.Err(); err != nil {
+		return NewChallengesResponse{ResponseMeta: resp.ResponseMeta}, err
+	}
+	for i, info := range infos.Succeeded {
+		items[i].AuthorID = info.Data.CreatorId
+		items[i].AuthorName = info.Data.CreatorName
+	}
+
+	return NewChallengesResponse{
+		Data:         items,
+		ResponseMeta: resp.ResponseMeta,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/fieldmask"
 	"github.com/gubarz/gohtb/internal/ptr"
 )
 
@@ -275,6 +276,32 @@ func (q *ChallengeQuery) ByKeyword(keyword string) *ChallengeQuery {
 	return qc
 }
 
+// Fields keeps only the named fields of each returned item and zeroes the
+// rest. Names are JSON keys or Go field names, matched without regard to
+// case; an unknown name makes Results fail.
+//
+// The API has no sparse fieldset support, so the projection is done
+// client-side: every page is still downloaded and decoded in full, and
+// ResponseMeta.Raw holds the whole body. Only the decoded items are
+// pruned, which lets the dropped values be garbage collected when large
+// lists are retained.
+// Returns a new ChallengeQuery that can be further chained.
+//
+// Example:
+//
+//	challenges, err := client.Challenges.List().Fields("name", "difficulty").AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, c := range challenges.Data {
+//		fmt.Println(c.Name)
+//	}
+func (q *ChallengeQuery) Fields(names ...string) *ChallengeQuery {
+	qc := ptr.Clone(q)
+	qc.fields = append([]string(nil), names...)
+	return qc
+}
+
 func (q *ChallengeQuery) fetchResults(ctx context.Context) (ChallengeListResponse, error) {
 	params := &v4Client.GetChallengesParams{
 		Page:    &q.page,
@@ -320,10 +347,14 @@ func (q *ChallengeQuery) fetchResults(ctx context.Context) (ChallengeListRespons
 		return ChallengeListResponse{ResponseMeta: meta}, err
 	}
 
-	return ChallengeListResponse{
+	out := ChallengeListResponse{
 		Data:         parsed.JSON200.Data,
 		ResponseMeta: meta,
-	}, nil
+	}
+	if err := fieldmask.Prune(out.Data, q.fields); err != nil {
+		return ChallengeListResponse{ResponseMeta: meta}, err
+	}
+	return out, nil
 }
 
 // Results executes the query and returns the current page of challenges.
@@ -0,0 +1,88 @@
+package challenges
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// ErrAllSolved is returned by RandomUnsolved when the authenticated user has
+// solved every challenge in the requested category.
+var ErrAllSolved = errors.New("all challenges solved")
+
+type RandomUnsolvedResponse struct {
+	Data         ChallengeList
+	ResponseMeta common.ResponseMeta
+}
+
+// RandomUnsolved picks a challenge the authenticated user has not solved yet,
+// uniformly at random using crypto/rand. category is a category name such as
+// "Web", matched ignoring case; an empty category picks from all of them.
+// An unknown category is an error, and ErrAllSolved is returned when nothing
+// in the category is left to solve.
+//
+// Example:
+//
+//	pick, err := client.Challenges.RandomUnsolved(ctx, "Crypto")
+//	if errors.Is(err, challenges.ErrAllSolved) {
+//		fmt.Println("Nothing left to practice")
+//		return
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Try %s (%s)\n", pick.Data.Name, pick.Data.Difficulty)
+func (s *Service) RandomUnsolved(ctx context.Context, category string) (RandomUnsolvedResponse, error) {
+	q := s.List()
+	if category != "" {
+		categories, err := s.Categories(ctx)
+		if err != nil {
+			return RandomUnsolvedResponse{ResponseMeta: categories.ResponseMeta}, err
+		}
+		id := 0
+		for _, c := range categories.Data {
+			if strings.EqualFold(c.Name, category) {
+				id = c.Id
+				break
+			}
+		}
+		if id == 0 {
+			return RandomUnsolvedResponse{ResponseMeta: categories.ResponseMeta}, fmt.Errorf("unknown challenge category %q", category)
+		}
+		q = q.ByCategory(id)
+	}
+	q.status = v4Client.GetChallengesParamsStatusIncompleted
+
+	list, err := q.AllResults(ctx)
+	if err != nil {
+		return RandomUnsolvedResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+
+	unsolved := make([]ChallengeList, 0, len(list.Data))
+	for _, c := range list.Data {
+		if !c.IsOwned {
+			unsolved = append(unsolved, c)
+		}
+	}
+	if len(unsolved) == 0 {
+		if category != "" {
+			return RandomUnsolvedResponse{ResponseMeta: list.ResponseMeta}, fmt.Errorf("category %q: %w", category, ErrAllSolved)
+		}
+		return RandomUnsolvedResponse{ResponseMeta: list.ResponseMeta}, ErrAllSolved
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(unsolved))))
+	if err != nil {
+		return RandomUnsolvedResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	return RandomUnsolvedResponse{
+		Data:         unsolved[n.Int64()],
+		ResponseMeta: list.ResponseMeta,
+	}, nil
+}
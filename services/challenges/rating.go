@@ -0,0 +1,93 @@
+package challenges
+
+import (
+	"context"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+type AverageRatingResponse struct {
+	// Data is the mean user-rated difficulty from 1 (Piece of Cake) to 10
+	// (Brainfuck), or zero if nobody has rated the challenge.
+	Data float64
+	// RatingCount is the number of users who rated the challenge.
+	RatingCount  int
+	ResponseMeta common.ResponseMeta
+}
+
+type RatingDistributionResponse struct {
+	// Data maps each rating from 1 to 10 to the number of users who gave
+	// it. Every rating is present, including those nobody chose.
+	Data         map[int]int
+	ResponseMeta common.ResponseMeta
+}
+
+// AverageRating returns the community's view of the challenge's difficulty:
+// the mean of the difficulty ratings users submit with their flags, on the
+// same 1 to 10 scale as the chart on the challenge page.
+//
+// Example:
+//
+//	rating, err := client.Challenges.Challenge(12345).AverageRating(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Rated %.1f/10 by %d users\n", rating.Data, rating.RatingCount)
+func (h *Handle) AverageRating(ctx context.Context) (AverageRatingResponse, error) {
+	dist, err := h.RatingDistribution(ctx)
+	if err != nil {
+		return AverageRatingResponse{ResponseMeta: dist.ResponseMeta}, err
+	}
+
+	count, sum := 0, 0
+	for rating, n := range dist.Data {
+		count += n
+		sum += rating * n
+	}
+	avg := 0.0
+	if count > 0 {
+		avg = float64(sum) / float64(count)
+	}
+
+	return AverageRatingResponse{
+		Data:         avg,
+		RatingCount:  count,
+		ResponseMeta: dist.ResponseMeta,
+	}, nil
+}
+
+// RatingDistribution returns how many users gave the challenge each
+// difficulty rating, from 1 (Piece of Cake) to 10 (Brainfuck).
+//
+// Example:
+//
+//	dist, err := client.Challenges.Challenge(12345).RatingDistribution(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for rating := 1; rating <= 10; rating++ {
+//		fmt.Printf("%2d: %d\n", rating, dist.Data[rating])
+//	}
+func (h *Handle) RatingDistribution(ctx context.Context) (RatingDistributionResponse, error) {
+	info, err := h.Info(ctx)
+	if err != nil {
+		return RatingDistributionResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	c := info.Data.DifficultyChart
+	return RatingDistributionResponse{
+		Data: map[int]int{
+			1:  c.CounterCake,
+			2:  c.CounterVeryEasy,
+			3:  c.CounterEasy,
+			4:  c.CounterTooEasy,
+			5:  c.CounterMedium,
+			6:  c.CounterBitHard,
+			7:  c.CounterHard,
+			8:  c.CounterTooHard,
+			9:  c.CounterExHard,
+			10: c.CounterBrainFuck,
+		},
+		ResponseMeta: info.ResponseMeta,
+	}, nil
+}
@@ -2,13 +2,20 @@ package challenges
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"strconv"
+	"sync/atomic"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/common"
 	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/extract"
+	"github.com/gubarz/gohtb/internal/flagutil"
 	"github.com/gubarz/gohtb/internal/service"
+	"github.com/gubarz/gohtb/internal/stream"
 	"github.com/gubarz/gohtb/services/containers"
 )
 
@@ -24,11 +31,14 @@ type ChallengeQuery struct {
 	todo       v4Client.GetChallengesParamsTodo
 	page       int
 	perPage    int
+	fields     []string
 }
 
 type Service struct {
 	base    service.Base
 	product string
+	// categoryCounts holds the most recent CategoryCounts result.
+	categoryCounts atomic.Pointer[categoryCountsEntry]
 }
 
 // NewService creates a new challenges service bound to a shared client.
@@ -380,6 +390,12 @@ func (h *Handle) Stop(ctx context.Context) (common.MessageResponse, error) {
 // The difficulty parameter is a user rating (1-100) of how difficult the challenge was.
 // If difficulty is 0 or negative, it defaults to 10.
 //
+// The submission is never retried blindly. If it fails in a way that leaves
+// its outcome unknown (a timeout, a dropped connection or a 5xx response),
+// Own fetches the challenge info to check whether the caller has solved it.
+// If so, the flag is not sent again and a response saying so is returned.
+// Otherwise it is submitted once more.
+//
 // Example:
 //
 //	result, err := client.Challenges.Challenge(12345).Own(ctx, "HTB{example_flag_here}", 10)
@@ -388,11 +404,37 @@ func (h *Handle) Stop(ctx context.Context) (common.MessageResponse, error) {
 //	}
 //	fmt.Printf("Flag submission: %s\n", result.Data.Message)
 func (h *Handle) Own(ctx context.Context, flag string, difficulty int) (common.MessageResponse, error) {
+	flag, err := flagutil.Normalize(h.client, flag, flagutil.Wrapped)
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
 	if difficulty <= 0 {
 		difficulty = 10
 	}
+
+	result, err := h.submitOwn(ctx, flag, difficulty)
+	if !flagutil.Uncertain(ctx, err) {
+		return result, err
+	}
+
+	info, infoErr := h.Info(ctx)
+	if infoErr != nil {
+		return result, err
+	}
+	if info.Data.AuthUserSolve {
+		return common.MessageResponse{
+			Data: common.Message{
+				Message: "challenge already solved; flag not resubmitted after an uncertain failure",
+			},
+			ResponseMeta: info.ResponseMeta,
+		}, nil
+	}
+	return h.submitOwn(ctx, flag, difficulty)
+}
+
+func (h *Handle) submitOwn(ctx context.Context, flag string, difficulty int) (common.MessageResponse, error) {
 	resp, err := h.client.V4().PostChallengeOwnWithFormdataBody(
-		h.client.Limiter().Wrap(ctx),
+		common.WithoutUnsafeRetry(h.client.Limiter().Wrap(ctx)),
 		v4Client.ChallengeOwnRequest{
 			ChallengeId: h.id,
 			Difficulty:  difficulty,
@@ -589,13 +631,8 @@ func (h *Handle) WriteupOfficial(ctx context.Context) (WriteupOfficialResponse,
 	}
 
 	return WriteupOfficialResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetChallengeWriteupOfficial"),
 	}, nil
 }
 
@@ -651,13 +688,8 @@ func (h *Handle) Download(ctx context.Context) (DownloadResponse, error) {
 		})
 	}
 	return DownloadResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetChallengeDownload"),
 	}, nil
 }
 
@@ -736,3 +768,50 @@ func (h *Handle) MarkReviewHelpful(ctx context.Context, reviewId int) (common.Me
 		ResponseMeta: meta,
 	}, nil
 }
+
+type DownloadStreamResponse struct {
+	// Written is the number of bytes copied to the writer.
+	Written      int64
+	ResponseMeta common.ResponseMeta
+}
+
+// DownloadTo streams the challenge files to w instead of buffering them in
+// memory, calling progress as bytes arrive. total is taken from the
+// Content-Length header and is -1 when the server does not send one.
+// progress may be nil.
+//
+// Example:
+//
+//	f, err := os.Create("challenge.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	_, err = client.Challenges.Challenge(12345).DownloadTo(ctx, f, func(downloaded, total int64) {
+//		fmt.Printf("\r%d / %d bytes", downloaded, total)
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) DownloadTo(ctx context.Context, w io.Writer, progress func(downloaded, total int64)) (DownloadStreamResponse, error) {
+	resp, err := h.client.V4().GetChallengeDownload(
+		h.client.Limiter().Wrap(ctx),
+		h.id,
+	)
+	if err != nil || resp == nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		raw := extract.Raw(resp)
+		if err == nil {
+			err = fmt.Errorf("unexpected status code %d", common.SafeStatus(resp))
+		}
+		return errutil.UnwrapFailure(err, raw, common.SafeStatus(resp), func(raw []byte) DownloadStreamResponse {
+			return DownloadStreamResponse{ResponseMeta: common.ResponseMeta{Raw: raw}}
+		})
+	}
+	defer resp.Body.Close()
+
+	meta := common.NewMeta(resp, nil, "GetChallengeDownload")
+
+	written, err := stream.Copy(clock.From(h.client), w, resp.Body, resp.ContentLength, progress)
+	return DownloadStreamResponse{Written: written, ResponseMeta: meta}, err
+}
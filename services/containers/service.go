@@ -4,7 +4,9 @@ import (
 	"context"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/service"
 )
 
@@ -55,6 +57,12 @@ func (s *Service) Container(id int) *Handle {
 //	}
 //	fmt.Printf("Container started: %s\n", result.Data.Message)
 func (h *Handle) Start(ctx context.Context) (common.MessageResponse, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	resp, err := h.client.V4().PostContainerStartWithFormdataBody(
 		h.client.Limiter().Wrap(ctx),
 		v4Client.PostContainerStartFormdataRequestBody{
@@ -66,8 +74,9 @@ func (h *Handle) Start(ctx context.Context) (common.MessageResponse, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostContainerStartResponse)
+	meta.QueueWait = wait
 	if err != nil {
-		return common.MessageResponse{ResponseMeta: meta}, err
+		return common.MessageResponse{ResponseMeta: meta}, errutil.InstanceLimit(err, meta.Headers, meta.Raw, clock.From(h.client).Now())
 	}
 
 	return common.MessageResponse{
@@ -89,6 +98,12 @@ func (h *Handle) Start(ctx context.Context) (common.MessageResponse, error) {
 //	}
 //	fmt.Printf("Container stopped: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Stop(ctx context.Context) (common.MessageResponse, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	resp, err := h.client.V4().PostContainerStopWithFormdataBody(
 		h.client.Limiter().Wrap(ctx),
 		v4Client.PostContainerStopFormdataRequestBody{
@@ -100,6 +115,7 @@ func (h *Handle) Stop(ctx context.Context) (common.MessageResponse, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostContainerStopResponse)
+	meta.QueueWait = wait
 	if err != nil {
 		return common.MessageResponse{ResponseMeta: meta}, err
 	}
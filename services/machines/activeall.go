@@ -0,0 +1,111 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
+	"github.com/gubarz/gohtb/services/seasons"
+)
+
+// InstanceProduct identifies where a running instance was spawned.
+type InstanceProduct string
+
+const (
+	// InstanceLab is the lab machine slot, which also serves Starting
+	// Point and retired machines. Type says which.
+	InstanceLab InstanceProduct = "lab"
+	// InstanceSeason is the seasonal machine.
+	InstanceSeason InstanceProduct = "season"
+)
+
+// RunningInstance is one machine instance running for the authenticated
+// user.
+type RunningInstance struct {
+	Product InstanceProduct
+	// Type is the API's own label for the instance, when it gives one.
+	Type      string
+	MachineID int
+	Name      string
+	IP        string
+	// Spawning is set while the instance is still starting and IP may be
+	// empty.
+	Spawning bool
+	// ExpiresAt is zero if the API did not report an expiry.
+	ExpiresAt time.Time
+}
+
+type ActiveAllResponse struct {
+	Data         []RunningInstance
+	ResponseMeta common.ResponseMeta
+}
+
+// ActiveAll returns every machine instance running for the authenticated
+// user: the lab machine slot and the seasonal machine. Data is empty when
+// nothing is running. A season endpoint answering 404 because no season is
+// on counts as no seasonal instance.
+//
+// Pro Labs, Endgames and Fortresses are shared environments with no
+// per-user instance, so they never appear; use the VPN service to see
+// which of them the user is connected to.
+//
+// Example:
+//
+//	running, err := client.Machines.ActiveAll(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, inst := range running.Data {
+//		fmt.Printf("[%s] %s at %s\n", inst.Product, inst.Name, inst.IP)
+//	}
+func (s *Service) ActiveAll(ctx context.Context) (ActiveAllResponse, error) {
+	out := []RunningInstance{}
+
+	active, err := s.Active(ctx)
+	if err != nil {
+		return ActiveAllResponse{ResponseMeta: active.ResponseMeta}, err
+	}
+	if info := active.Data; info.Id != 0 {
+		inst := RunningInstance{
+			Product:   InstanceLab,
+			Type:      info.Type,
+			MachineID: info.Id,
+			Name:      info.Name,
+			IP:        info.Ip,
+			Spawning:  info.IsSpawning,
+		}
+		if t := parseActivityTime(info.ExpiresAt); t != nil {
+			inst.ExpiresAt = *t
+		}
+		out = append(out, inst)
+	}
+
+	season, err := seasons.NewService(s.base.Client).ActiveMachine(ctx)
+	var apiErr *errutil.APIError
+	switch {
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+	case err != nil:
+		return ActiveAllResponse{ResponseMeta: season.ResponseMeta}, err
+	default:
+		m := season.Data
+		play := m.PlayInfo
+		if (play.IsSpawned || play.IsSpawning) && (len(out) == 0 || out[0].MachineID != m.Id) {
+			out = append(out, RunningInstance{
+				Product:   InstanceSeason,
+				MachineID: m.Id,
+				Name:      m.Name,
+				IP:        m.Ip,
+				Spawning:  play.IsSpawning && !play.IsSpawned,
+				ExpiresAt: play.ExpiresAt,
+			})
+		}
+	}
+
+	return ActiveAllResponse{
+		Data:         out,
+		ResponseMeta: active.ResponseMeta,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package machines
+
+import (
+	"context"
+	"slices"
+
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/ptr"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// ListByAuthor retrieves the active and retired machines created or
+// co-created by the given user. If the user created no machines, Data is
+// an empty slice and no error is returned.
+//
+// The author's machines are looked up server-side through their profile;
+// the machine list is then paged only until all of them have been found.
+//
+// Example:
+//
+//	machines, err := client.Machines.ListByAuthor(ctx, 12345)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range machines.Data {
+//		fmt.Printf("%s (%s)\n", m.Name, m.DifficultyText)
+//	}
+func (s *Service) ListByAuthor(ctx context.Context, authorID int) (MachinesResponse, error) {
+	profile, err := users.NewService(s.base.Client).User(authorID).Profile(ctx)
+	if err != nil {
+		return MachinesResponse{ResponseMeta: profile.ResponseMeta}, err
+	}
+
+	out := MachinesDataItems{}
+	remaining := make(map[int]bool, len(profile.Data.MachinesCreated))
+	for _, id := range profile.Data.MachinesCreated {
+		remaining[id] = true
+	}
+	if len(remaining) == 0 {
+		return MachinesResponse{Data: out, ResponseMeta: profile.ResponseMeta}, nil
+	}
+
+	q := s.List().ByStateList("active", "retired")
+	meta := profile.ResponseMeta
+	for len(remaining) > 0 {
+		resp, err := q.fetchResults(ctx)
+		if err != nil {
+			return MachinesResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+		meta = resp.ResponseMeta
+
+		for _, m := range resp.Data {
+			if remaining[m.Id] || isCreator(m, authorID) {
+				delete(remaining, m.Id)
+				out = append(out, m)
+			}
+		}
+
+		if len(resp.Data) < q.perPage {
+			break
+		}
+		q = ptr.Clone(q)
+		q.page++
+	}
+
+	return MachinesResponse{
+		Data:         out,
+		ResponseMeta: meta,
+	}, nil
+}
+
+func isCreator(m MachinesData, userID int) bool {
+	if m.FirstCreator.Id == userID {
+		return true
+	}
+	return slices.ContainsFunc(m.Cocreators, func(c v5Client.MachineCreator) bool { return c.Id == userID })
+}
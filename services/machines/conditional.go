@@ -0,0 +1,75 @@
+package machines
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/ptr"
+)
+
+// ListIfModifiedSince fetches the full machine catalog only if it changed
+// after t. When the API answers 304 Not Modified, the response has
+// ResponseMeta.NotModified set, no data and a nil error, and the caller's
+// earlier copy is still current. Pass the time the earlier copy was taken.
+//
+// Only the first page is requested conditionally; once the catalog is known
+// to have changed, the remaining pages are fetched normally. A 200 answer
+// is checked for a server that ignored the condition: if it has no
+// Last-Modified header, or one that is not after t, the full catalog is
+// returned as a plain fetch and a warning is logged, so a server that never
+// honours If-Modified-Since does not go unnoticed.
+//
+// Example:
+//
+//	catalog, err := client.Machines.List().AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fetchedAt := time.Now()
+//	// ... later
+//	update, err := client.Machines.ListIfModifiedSince(ctx, fetchedAt)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if !update.ResponseMeta.NotModified {
+//		catalog, fetchedAt = update, time.Now()
+//	}
+func (s *Service) ListIfModifiedSince(ctx context.Context, t time.Time) (MachinesResponse, error) {
+	q := s.List()
+
+	first := ptr.Clone(q)
+	first.ifModifiedSince = t
+	resp, err := first.fetchResults(ctx)
+	if err != nil || resp.ResponseMeta.NotModified {
+		return resp, err
+	}
+
+	if logger := s.base.Client.Logger(); logger != nil {
+		lm, err := http.ParseTime(resp.ResponseMeta.Headers.Get("Last-Modified"))
+		switch {
+		case err != nil:
+			logger.Warn("GetMachines answered If-Modified-Since %v without Last-Modified, so it may have ignored it; returning the full catalog", t)
+		case !lm.After(t):
+			logger.Warn("GetMachines ignored If-Modified-Since %v (Last-Modified %v); returning the full catalog", t, lm)
+		}
+	}
+
+	all := resp.Data
+	meta := resp.ResponseMeta
+	for page := 2; len(resp.Data) >= q.perPage; page++ {
+		qp := ptr.Clone(q)
+		qp.page = page
+		resp, err = qp.fetchResults(ctx)
+		if err != nil {
+			return MachinesResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+		all = append(all, resp.Data...)
+		meta = resp.ResponseMeta
+	}
+
+	return MachinesResponse{
+		Data:         all,
+		ResponseMeta: meta,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package machines_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/gubarz/gohtb/services/machines"
+)
+
+func ExampleHandle_Info() {
+	srv := gohtbtest.NewServer().
+		JSON("GetMachineProfile", `{"info":{"id":660,"name":"Editorial","os":"Linux","difficultyText":"Easy"}}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	info, err := client.Machines.Machine(660).Info(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Machine: %s (%s, %s)\n", info.Data.Name, info.Data.Os, info.Data.DifficultyText)
+	// Output:
+	// Machine: Editorial (Linux, Easy)
+}
+
+func ExampleHandle_Own() {
+	srv := gohtbtest.NewServer().
+		JSON("PostMachineOwn", `{"id":660,"success":true,"message":"Editorial user is now owned.","own_type":"user","points":10}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := client.Machines.Machine(660).Own(context.Background(), "60b725f10c9c85c70d97880dfe8191b3")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s (+%d points)\n", result.Data.Message, result.Data.Points)
+	// Output:
+	// Editorial user is now owned. (+10 points)
+}
+
+func ExampleMachineQuery_Next() {
+	names := []string{"Lame", "Legacy", "Devel", "Optimum", "Blue"}
+	srv := gohtbtest.NewServer().
+		HandleFunc("GetMachines", func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+			var rows []string
+			for i := (page - 1) * perPage; i < min(page*perPage, len(names)); i++ {
+				rows = append(rows, fmt.Sprintf(`{"id":%d,"name":%q}`, i+1, names[i]))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(rows, ","))
+		})
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var retired machines.MachinesDataItems
+	query := client.Machines.List().ByState("retired").PerPage(2)
+	for page := 1; ; page++ {
+		resp, err := query.Results(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, m := range resp.Data {
+			fmt.Printf("page %d: %s\n", page, m.Name)
+		}
+		retired = append(retired, resp.Data...)
+		if len(resp.Data) < 2 {
+			break
+		}
+		query = query.Next()
+	}
+	fmt.Println("retired machines:", len(retired))
+	// Output:
+	// page 1: Lame
+	// page 1: Legacy
+	// page 2: Devel
+	// page 2: Optimum
+	// page 3: Blue
+	// retired machines: 5
+}
@@ -0,0 +1,99 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gubarz/gohtb/humanize"
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+var (
+	// ErrNotSpawned is returned by TimeRemaining and Extend when the machine
+	// is not the authenticated user's active instance.
+	ErrNotSpawned = errors.New("machine is not spawned")
+	// ErrCannotExtend is returned by Extend when the API refuses to extend a
+	// running instance, typically because its maximum lifetime is reached.
+	ErrCannotExtend = errors.New("machine cannot be extended further")
+)
+
+type TimeRemainingResponse struct {
+	// Data is the time left before the instance expires. It is zero or
+	// negative once the expiry has passed.
+	Data         time.Duration
+	ExpiresAt    time.Time
+	ResponseMeta common.ResponseMeta
+}
+
+// ExpiresIn describes the instance expiry relative to now, such as
+// "in 2 hours", or "5 minutes ago" once it has passed.
+func (r TimeRemainingResponse) ExpiresIn(now time.Time) string {
+	return humanize.Relative(r.ExpiresAt, now)
+}
+
+// TimeRemaining reports how long the machine's active instance has left
+// before it expires. It returns ErrNotSpawned if the machine is not the
+// active instance.
+//
+// Example:
+//
+//	left, err := client.Machines.Machine(12345).TimeRemaining(ctx)
+//	if errors.Is(err, machines.ErrNotSpawned) {
+//		fmt.Println("Machine is not running")
+//		return
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Expires in %s\n", left.Data.Round(time.Minute))
+func (h *Handle) TimeRemaining(ctx context.Context) (TimeRemainingResponse, error) {
+	active, err := NewService(h.client, h.product).Active(ctx)
+	if err != nil {
+		return TimeRemainingResponse{ResponseMeta: active.ResponseMeta}, err
+	}
+	if active.Data.Id != h.id {
+		return TimeRemainingResponse{ResponseMeta: active.ResponseMeta}, fmt.Errorf("machine %d: %w", h.id, ErrNotSpawned)
+	}
+
+	expiresAt := parseActivityTime(active.Data.ExpiresAt)
+	if expiresAt == nil {
+		return TimeRemainingResponse{ResponseMeta: active.ResponseMeta}, fmt.Errorf("machine %d: expiry not reported", h.id)
+	}
+
+	return TimeRemainingResponse{
+		Data:         expiresAt.Sub(clock.From(h.client).Now()),
+		ExpiresAt:    *expiresAt,
+		ResponseMeta: active.ResponseMeta,
+	}, nil
+}
+
+// extendError classifies a refused extension. Cooldowns, daily limits and
+// transient failures are returned unchanged; anything else is checked
+// against the active instance to tell ErrNotSpawned from ErrCannotExtend.
+// message is used when the refusal came as a 200 response with success
+// false rather than an error status.
+func (h *Handle) extendError(ctx context.Context, err error, message string) error {
+	if err != nil {
+		var apiErr *errutil.APIError
+		var cooldown *errutil.ErrCooldown
+		var daily *errutil.ErrDailyLimitReached
+		if !errors.As(err, &apiErr) || errutil.Transient(err) ||
+			apiErr.StatusCode < http.StatusBadRequest ||
+			errors.As(err, &cooldown) || errors.As(err, &daily) {
+			return err
+		}
+	} else {
+		err = errors.New(message)
+	}
+
+	active, activeErr := NewService(h.client, h.product).Active(ctx)
+	if activeErr == nil && active.Data.Id != h.id {
+		return fmt.Errorf("machine %d: %w: %w", h.id, ErrNotSpawned, err)
+	}
+	return fmt.Errorf("machine %d: %w: %w", h.id, ErrCannotExtend, err)
+}
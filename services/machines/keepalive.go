@@ -0,0 +1,146 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// KeepAliveStopReason describes why KeepAlive gave up on an instance.
+type KeepAliveStopReason string
+
+const (
+	// KeepAliveInstanceStopped means the machine is no longer the active
+	// instance, for example because it was terminated or expired.
+	KeepAliveInstanceStopped KeepAliveStopReason = "instance stopped"
+	// KeepAliveExtendRefused means the API rejected an extension, typically
+	// because the maximum lifetime has been reached.
+	KeepAliveExtendRefused KeepAliveStopReason = "extension refused"
+)
+
+// KeepAliveError is returned by KeepAlive when it stops for a reason other
+// than context cancellation. Err holds the underlying API error, if any.
+type KeepAliveError struct {
+	MachineID int
+	Reason    KeepAliveStopReason
+	Message   string
+	Err       error
+}
+
+func (e *KeepAliveError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("keep-alive for machine %d stopped: %s: %s", e.MachineID, e.Reason, e.Message)
+	}
+	return fmt.Sprintf("keep-alive for machine %d stopped: %s", e.MachineID, e.Reason)
+}
+
+func (e *KeepAliveError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	keepAliveMaxPoll        = 5 * time.Minute
+	keepAliveUnknownPoll    = time.Minute
+	keepAliveInitialBackoff = 2 * time.Second
+	keepAliveMaxBackoff     = 2 * time.Minute
+)
+
+// KeepAlive watches the machine's active instance and extends it whenever
+// the remaining time drops below margin. It blocks until ctx is cancelled,
+// returning ctx.Err(), or until the instance stops or an extension is
+// refused, returning a *KeepAliveError.
+//
+// The expiry is always re-read from the API after an extension. Transient
+// failures (network errors, 429 and 5xx responses) are retried with
+// exponential backoff; other errors are returned as is. KeepAlive holds no
+// locks between requests, so other calls on the same client proceed
+// normally while it runs.
+//
+// Example:
+//
+//	err := client.Machines.Machine(12345).KeepAlive(ctx, 15*time.Minute)
+//	var stop *machines.KeepAliveError
+//	if errors.As(err, &stop) {
+//		fmt.Printf("Instance no longer kept alive: %s\n", stop.Reason)
+//	}
+func (h *Handle) KeepAlive(ctx context.Context, margin time.Duration) error {
+	clk := clock.From(h.client)
+	s := NewService(h.client, h.product)
+
+	var backoff time.Duration
+	retry := func() error {
+		backoff = min(max(backoff*2, keepAliveInitialBackoff), keepAliveMaxBackoff)
+		return clock.Sleep(ctx, clk, backoff)
+	}
+
+	var extendedFrom time.Time
+	for {
+		active, err := s.Active(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !errutil.Transient(err) {
+				return err
+			}
+			if err := retry(); err != nil {
+				return err
+			}
+			continue
+		}
+		backoff = 0
+
+		if active.Data.Id != h.id {
+			return &KeepAliveError{MachineID: h.id, Reason: KeepAliveInstanceStopped}
+		}
+
+		expiresAt := parseActivityTime(active.Data.ExpiresAt)
+		if expiresAt == nil {
+			if err := clock.Sleep(ctx, clk, keepAliveUnknownPoll); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !extendedFrom.IsZero() {
+			if !expiresAt.After(extendedFrom) {
+				return &KeepAliveError{
+					MachineID: h.id,
+					Reason:    KeepAliveExtendRefused,
+					Message:   "expiry did not advance after extension",
+				}
+			}
+			extendedFrom = time.Time{}
+		}
+
+		remaining := expiresAt.Sub(clk.Now())
+		if remaining > margin {
+			if err := clock.Sleep(ctx, clk, min(remaining-margin, keepAliveMaxPoll)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		_, err = h.Extend(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errutil.Transient(err) {
+				if err := retry(); err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, ErrNotSpawned) {
+				return &KeepAliveError{MachineID: h.id, Reason: KeepAliveInstanceStopped, Err: err}
+			}
+			return &KeepAliveError{MachineID: h.id, Reason: KeepAliveExtendRefused, Err: err}
+		}
+		extendedFrom = *expiresAt
+	}
+}
@@ -0,0 +1,130 @@
+package machines
+
+import (
+	"context"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// BloodType identifies which first blood a solver took.
+type BloodType string
+
+const (
+	BloodUser BloodType = "user"
+	BloodRoot BloodType = "root"
+)
+
+// Solver is one entry on a machine's solver leaderboard.
+type Solver struct {
+	// Rank is the solver's position by first own, starting at 1. It is
+	// computed before BloodOnly filtering, so it stays the same either way.
+	Rank     int
+	UserID   int
+	Username string
+	// Country is the solver's country name from their profile, or empty if
+	// the profile could not be fetched.
+	Country     string
+	UserOwnedAt *time.Time
+	RootOwnedAt *time.Time
+	// BloodType is set when the solver took a first blood, preferring root
+	// over user if they took both.
+	BloodType *BloodType
+}
+
+type SolversPageResponse struct {
+	Data         []Solver
+	Pagination   PagingMeta
+	ResponseMeta common.ResponseMeta
+}
+
+type leaderboardOptions struct {
+	bloodOnly bool
+}
+
+// LeaderboardOption configures Leaderboard.
+type LeaderboardOption func(*leaderboardOptions)
+
+// BloodOnly limits Leaderboard to solvers who took a first blood.
+func BloodOnly() LeaderboardOption {
+	return func(o *leaderboardOptions) {
+		o.bloodOnly = true
+	}
+}
+
+// Leaderboard returns one page of the machine's solvers, ranked by their
+// first own, with the time of each flag and any first blood taken. Paging
+// follows Owners: a page below 1 is treated as 1, and a perPage of zero or
+// less returns every solver on a single page.
+//
+// Solvers come from the machine's activity feed, so the same limits as
+// Owners apply. The feed has no country, so the profile of each solver on
+// the returned page is fetched with bounded parallelism; a failed profile
+// leaves Country empty rather than failing the call.
+//
+// Example:
+//
+//	bloods, err := client.Machines.Machine(12345).Leaderboard(ctx, 1, 10, machines.BloodOnly())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range bloods.Data {
+//		fmt.Printf("#%d %s (%s) %s blood\n", s.Rank, s.Username, s.Country, *s.BloodType)
+//	}
+func (h *Handle) Leaderboard(ctx context.Context, page, perPage int, opts ...LeaderboardOption) (SolversPageResponse, error) {
+	var o leaderboardOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	owners, meta, err := h.owners(ctx)
+	if err != nil {
+		return SolversPageResponse{ResponseMeta: meta}, err
+	}
+
+	solvers := make([]Solver, 0, len(owners))
+	for i, owner := range owners {
+		s := Solver{
+			Rank:        i + 1,
+			UserID:      owner.UserID,
+			Username:    owner.Username,
+			UserOwnedAt: owner.UserOwnedAt,
+			RootOwnedAt: owner.RootOwnedAt,
+		}
+		switch {
+		case owner.RootBlood:
+			blood := BloodRoot
+			s.BloodType = &blood
+		case owner.UserBlood:
+			blood := BloodUser
+			s.BloodType = &blood
+		}
+		if o.bloodOnly && s.BloodType == nil {
+			continue
+		}
+		solvers = append(solvers, s)
+	}
+
+	start, end, pagination := paginate(len(solvers), page, perPage)
+	solvers = solvers[start:end]
+
+	userService := users.NewService(h.client)
+	countries, err := batch.Collect(ctx, len(solvers), batch.DefaultConcurrency, func(ctx context.Context, i int) (string, error) {
+		profile, err := userService.User(solvers[i].UserID).ProfileBasic(ctx)
+		return profile.Data.CountryName, err
+	})
+	if err != nil {
+		return SolversPageResponse{ResponseMeta: meta}, err
+	}
+	for i, country := range countries.Succeeded {
+		solvers[i].Country = country
+	}
+
+	return SolversPageResponse{
+		Data:         solvers,
+		Pagination:   pagination,
+		ResponseMeta: meta,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package machines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gubarz/gohtb/assets"
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// MakerProfile is a machine creator with the profile details needed for
+// display. Enriched is false when the profile could not be fetched; ID,
+// Name and AvatarURL then come from the machine info alone.
+type MakerProfile struct {
+	ID   int
+	Name string
+	// AvatarURL is the absolute URL of the maker's avatar, or empty if
+	// they have none.
+	AvatarURL string
+	Respects  int
+	Enriched  bool
+}
+
+type MachineInfoWithMakers struct {
+	MachineProfileInfo
+	Makers []MakerProfile
+	// Warnings lists makers whose profile could not be fetched.
+	Warnings []string
+}
+
+type InfoWithMakersResponse struct {
+	Data         MachineInfoWithMakers
+	ResponseMeta common.ResponseMeta
+}
+
+// InfoWithMakers retrieves the machine info together with the profile of
+// each of its makers. Maker profiles are fetched concurrently once the info
+// is known. A maker whose profile cannot be fetched is kept with the details
+// from the machine info and a warning; only a failure to fetch the machine
+// info fails the call.
+//
+// Example:
+//
+//	info, err := client.Machines.Machine(12345).InfoWithMakers(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range info.Data.Makers {
+//		fmt.Printf("%s (%d respect) %s\n", m.Name, m.Respects, m.AvatarURL)
+//	}
+func (h *Handle) InfoWithMakers(ctx context.Context) (InfoWithMakersResponse, error) {
+	info, err := h.Info(ctx)
+	if err != nil {
+		return InfoWithMakersResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	makers := make([]MakerProfile, 0, 2)
+	for _, m := range []struct {
+		id           int
+		name, avatar string
+	}{
+		{info.Data.Maker.Id, info.Data.Maker.Name, info.Data.Maker.Avatar},
+		{info.Data.Maker2.Id, info.Data.Maker2.Name, info.Data.Maker2.Avatar},
+	} {
+		if m.id == 0 {
+			continue
+		}
+		makers = append(makers, MakerProfile{ID: m.id, Name: m.name, AvatarURL: avatarURL(m.avatar)})
+	}
+
+	userService := users.NewService(h.client)
+	profiles, err := batch.Collect(ctx, len(makers), batch.DefaultConcurrency, func(ctx context.Context, i int) (users.UserProfile, error) {
+		profile, err := userService.User(makers[i].ID).ProfileBasic(ctx)
+		return profile.Data, err
+	})
+	if err != nil {
+		return InfoWithMakersResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	var warnings []string
+	for i := range makers {
+		profile, ok := profiles.Succeeded[i]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("maker %d profile unavailable: %v", makers[i].ID, profiles.Failed[i]))
+			continue
+		}
+		makers[i].Name = profile.Name
+		makers[i].AvatarURL = avatarURL(profile.Avatar)
+		makers[i].Respects = profile.Respects
+		makers[i].Enriched = true
+	}
+
+	out := MachineInfoWithMakers{
+		MachineProfileInfo: info.Data,
+		Makers:             makers,
+		Warnings:           warnings,
+	}
+
+	return InfoWithMakersResponse{
+		Data:         out,
+		ResponseMeta: info.ResponseMeta,
+	}, nil
+}
+
+// avatarURL resolves an avatar path from the API, returning "" when there is
+// none or it cannot be resolved.
+func avatarURL(path string) string {
+	u, err := assets.Resolve("", path)
+	if err != nil {
+		return ""
+	}
+	return u
+}
@@ -0,0 +1,141 @@
+package machines_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userFlag = "11111111111111111111111111111111"
+	rootFlag = "22222222222222222222222222222222"
+)
+
+// ownServer is a machine whose own status changes as flags are accepted.
+// Submissions are answered with 502 Bad Gateway, whether or not they were
+// recorded, so Own cannot tell from the response if they landed, unless
+// ok says the nth submission (from 1) is answered normally.
+type ownServer struct {
+	mu         sync.Mutex
+	user, root bool
+	// record reports whether the nth submission is recorded.
+	record func(n int) bool
+	ok     func(n int) bool
+	posts  int
+}
+
+func (s *ownServer) serve(srv *gohtbtest.Server) {
+	srv.HandleFunc("GetMachineProfile", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"info":{"id":660,"authUserInUserOwns":%t,"authUserInRootOwns":%t}}`, s.user, s.root)
+	})
+	srv.HandleFunc("PostMachineOwn", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.posts++
+		ownType := ""
+		if s.record(s.posts) {
+			switch r.FormValue("flag") {
+			case userFlag:
+				s.user, ownType = true, "User"
+			case rootFlag:
+				s.root, ownType = true, "Root"
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if s.ok != nil && s.ok(s.posts) {
+			fmt.Fprintf(w, `{"id":660,"success":true,"own_type":%q}`, ownType)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"message":"Bad Gateway"}`)
+	})
+}
+
+func always(int) bool { return true }
+func never(int) bool  { return false }
+
+func newOwnClient(t *testing.T, machine *ownServer) *gohtb.Client {
+	t.Helper()
+	srv := gohtbtest.NewServer()
+	t.Cleanup(srv.Close)
+	machine.serve(srv)
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+	return client
+}
+
+func TestOwnAfterUncertainFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		user, root bool
+		// cached calls Info on the handle before Own.
+		cached    bool
+		record    func(int) bool
+		flag      string
+		wantPosts int
+		wantErr   bool
+		wantType  string
+	}{
+		{name: "user flag landed", cached: true, record: always, flag: userFlag, wantPosts: 1, wantType: "User"},
+		{name: "root flag landed", user: true, cached: true, record: always, flag: rootFlag, wantPosts: 1, wantType: "Root"},
+		{name: "root flag before user", cached: true, record: always, flag: rootFlag, wantPosts: 1, wantType: "Root"},
+		{name: "nothing landed", cached: true, record: never, flag: userFlag, wantPosts: 2, wantErr: true},
+		{name: "root flag lost with user owned", user: true, cached: true, record: never, flag: rootFlag, wantPosts: 2, wantErr: true},
+		{name: "user flag resubmitted when owned", user: true, cached: true, record: always, flag: userFlag, wantPosts: 2, wantErr: true},
+		{name: "resubmission lands", cached: true, record: func(n int) bool { return n == 2 }, flag: userFlag, wantPosts: 2, wantErr: true},
+		{name: "uncached user flag landed", record: always, flag: userFlag, wantPosts: 2, wantErr: true},
+		{name: "uncached machine fully owned", user: true, record: always, flag: rootFlag, wantPosts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := &ownServer{user: tt.user, root: tt.root, record: tt.record}
+			handle := newOwnClient(t, machine).Machines.Machine(660)
+			if tt.cached {
+				_, err := handle.Info(context.Background())
+				require.NoError(t, err)
+			}
+
+			result, err := handle.Own(context.Background(), tt.flag)
+
+			assert.Equal(t, tt.wantPosts, machine.posts)
+			if tt.wantErr {
+				apiErr, ok := gohtb.AsAPIError(err)
+				require.True(t, ok, "want an APIError, got %v", err)
+				assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, result.Data.Success)
+			assert.Equal(t, tt.wantType, string(result.Data.OwnType))
+		})
+	}
+}
+
+func TestOwnKeepsCachedStatusCurrent(t *testing.T) {
+	// The user flag is accepted outright, then the root flag is lost behind
+	// a 502. Had the cache kept the status from before the user flag, the
+	// user own would look like the root submission landing.
+	machine := &ownServer{record: func(n int) bool { return n == 1 }, ok: func(n int) bool { return n == 1 }}
+	handle := newOwnClient(t, machine).Machines.Machine(660)
+	_, err := handle.Info(context.Background())
+	require.NoError(t, err)
+
+	result, err := handle.Own(context.Background(), userFlag)
+	require.NoError(t, err)
+	assert.Equal(t, "User", string(result.Data.OwnType))
+
+	_, err = handle.Own(context.Background(), rootFlag)
+	require.Error(t, err)
+	assert.Equal(t, 3, machine.posts, "the root flag is submitted again")
+}
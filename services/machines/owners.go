@@ -0,0 +1,166 @@
+package machines
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// Owner is a user who owned the machine, with the time of each own.
+// UserOwnedAt or RootOwnedAt is nil when the user has not taken that flag.
+type Owner struct {
+	UserID      int
+	Username    string
+	UserOwnedAt *time.Time
+	RootOwnedAt *time.Time
+	// UserBlood and RootBlood report whether the own was a first blood.
+	UserBlood bool
+	RootBlood bool
+}
+
+// FirstOwnedAt returns the earliest of the user and root own times.
+func (o Owner) FirstOwnedAt() time.Time {
+	switch {
+	case o.UserOwnedAt == nil && o.RootOwnedAt == nil:
+		return time.Time{}
+	case o.UserOwnedAt == nil:
+		return *o.RootOwnedAt
+	case o.RootOwnedAt == nil:
+		return *o.UserOwnedAt
+	case o.RootOwnedAt.Before(*o.UserOwnedAt):
+		return *o.RootOwnedAt
+	default:
+		return *o.UserOwnedAt
+	}
+}
+
+type OwnersResponse struct {
+	Data         []Owner
+	Pagination   PagingMeta
+	ResponseMeta common.ResponseMeta
+}
+
+// Owners retrieves the users who owned the machine, ordered by their first
+// own, earliest first; owners without a parseable timestamp come last.
+// A page below 1 is treated as 1; a perPage of zero or less returns every
+// owner on a single page.
+//
+// Owners are built from the machine's activity feed, which the API serves in
+// a single response, so pagination is applied client-side after one request.
+// The feed only covers the machine's recent owns; for popular machines older
+// owners may be absent.
+//
+// Example:
+//
+//	owners, err := client.Machines.Machine(12345).Owners(ctx, 1, 25)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for i, o := range owners.Data {
+//		fmt.Printf("%d. %s (%s)\n", i+1, o.Username, o.FirstOwnedAt().Format(time.RFC3339))
+//	}
+func (h *Handle) Owners(ctx context.Context, page, perPage int) (OwnersResponse, error) {
+	owners, meta, err := h.owners(ctx)
+	if err != nil {
+		return OwnersResponse{ResponseMeta: meta}, err
+	}
+
+	start, end, pagination := paginate(len(owners), page, perPage)
+	return OwnersResponse{
+		Data:         owners[start:end],
+		Pagination:   pagination,
+		ResponseMeta: meta,
+	}, nil
+}
+
+// owners builds the machine's owners from its activity feed, ordered by
+// their first own.
+func (h *Handle) owners(ctx context.Context) ([]Owner, common.ResponseMeta, error) {
+	activity, err := h.Activity(ctx)
+	if err != nil {
+		return nil, activity.ResponseMeta, err
+	}
+
+	byUser := make(map[int]*Owner)
+	order := make([]*Owner, 0)
+	for _, a := range activity.Data {
+		if a.Type != "user" && a.Type != "root" {
+			continue
+		}
+		o, ok := byUser[a.UserId]
+		if !ok {
+			o = &Owner{UserID: a.UserId, Username: a.UserName}
+			byUser[a.UserId] = o
+			order = append(order, o)
+		}
+
+		at := parseActivityTime(a.CreatedAt, a.Date)
+		blood := a.BloodType != ""
+		switch a.Type {
+		case "user":
+			if o.UserOwnedAt == nil || (at != nil && at.Before(*o.UserOwnedAt)) {
+				o.UserOwnedAt = at
+			}
+			o.UserBlood = o.UserBlood || blood
+		case "root":
+			if o.RootOwnedAt == nil || (at != nil && at.Before(*o.RootOwnedAt)) {
+				o.RootOwnedAt = at
+			}
+			o.RootBlood = o.RootBlood || blood
+		}
+	}
+
+	owners := make([]Owner, len(order))
+	for i, o := range order {
+		owners[i] = *o
+	}
+	sort.SliceStable(owners, func(i, j int) bool {
+		a, b := owners[i].FirstOwnedAt(), owners[j].FirstOwnedAt()
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		return a.Before(b)
+	})
+	return owners, activity.ResponseMeta, nil
+}
+
+// paginate returns the bounds of page within total items. A page below 1 is
+// treated as 1; a perPage of zero or less puts everything on one page.
+func paginate(total, page, perPage int) (start, end int, meta PagingMeta) {
+	if perPage <= 0 {
+		perPage = total
+	}
+	if page < 1 {
+		page = 1
+	}
+	totalPages := 1
+	if perPage > 0 {
+		totalPages = (total + perPage - 1) / perPage
+	}
+
+	start = min((page-1)*perPage, total)
+	end = min(start+perPage, total)
+	return start, end, PagingMeta{
+		CurrentPage: page,
+		PerPage:     perPage,
+		Total:       total,
+		TotalPages:  totalPages,
+		Count:       end - start,
+	}
+}
+
+// parseActivityTime returns the first of the given timestamps that parses in
+// one of the layouts used by the machine endpoints, or nil if none does.
+func parseActivityTime(values ...string) *time.Time {
+	for _, s := range values {
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+			if t, err := time.Parse(layout, s); err == nil {
+				t = t.UTC()
+				return &t
+			}
+		}
+	}
+	return nil
+}
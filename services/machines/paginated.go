@@ -3,10 +3,13 @@ package machines
 import (
 	"context"
 	"errors"
+	"net/http"
 	"strings"
+	"time"
 
 	v5Client "github.com/gubarz/gohtb/httpclient/v5"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/fieldmask"
 	"github.com/gubarz/gohtb/internal/ptr"
 	"github.com/gubarz/gohtb/internal/service"
 )
@@ -24,6 +27,9 @@ type MachineQuery struct {
 	state         v5Client.State
 	free          *v5Client.GetMachinesParamsFree
 	todo          *v5Client.GetMachinesParamsTodo
+	fields        []string
+	// ifModifiedSince makes the request conditional when set.
+	ifModifiedSince time.Time
 }
 
 // List creates a new query for machines.
@@ -354,6 +360,32 @@ func (q *MachineQuery) ByTodo(val bool) *MachineQuery {
 	return qc
 }
 
+// Fields keeps only the named fields of each returned item and zeroes the
+// rest. Names are JSON keys or Go field names, matched without regard to
+// case; an unknown name makes Results fail.
+//
+// The API has no sparse fieldset support, so the projection is done
+// client-side: every page is still downloaded and decoded in full, and
+// ResponseMeta.Raw holds the whole body. Only the decoded items are
+// pruned, which lets the dropped values be garbage collected when large
+// lists are retained.
+// Returns a new MachineQuery that can be further chained.
+//
+// Example:
+//
+//	machines, err := client.Machines.List().Fields("Name", "Difficulty").AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range machines.Data {
+//		fmt.Println(m.Name)
+//	}
+func (q *MachineQuery) Fields(names ...string) *MachineQuery {
+	qc := ptr.Clone(q)
+	qc.fields = append([]string(nil), names...)
+	return qc
+}
+
 func (q *MachineQuery) fetchResults(ctx context.Context) (MachinesResponse, error) {
 	params := &v5Client.GetMachinesParams{
 		PerPage: &q.perPage,
@@ -402,20 +434,39 @@ func (q *MachineQuery) fetchResults(ctx context.Context) (MachinesResponse, erro
 		params.SortType = &st
 	}
 
-	resp, err := q.client.V5().GetMachines(q.client.Limiter().Wrap(ctx), params)
+	var editors []v5Client.RequestEditorFn
+	if !q.ifModifiedSince.IsZero() {
+		since := q.ifModifiedSince.UTC().Format(http.TimeFormat)
+		editors = append(editors, func(_ context.Context, req *http.Request) error {
+			req.Header.Set("If-Modified-Since", since)
+			return nil
+		})
+	}
+
+	resp, err := q.client.V5().GetMachines(q.client.Limiter().Wrap(ctx), params, editors...)
 	if err != nil {
 		return MachinesResponse{ResponseMeta: common.ResponseMeta{}}, err
 	}
+	if resp.StatusCode == http.StatusNotModified && !q.ifModifiedSince.IsZero() {
+		resp.Body.Close()
+		meta := common.NewMeta(resp, nil, "GetMachines")
+		meta.NotModified = true
+		return MachinesResponse{ResponseMeta: meta}, nil
+	}
 
 	parsed, meta, err := common.Parse(resp, v5Client.ParseGetMachinesResponse)
 	if err != nil {
 		return MachinesResponse{ResponseMeta: meta}, err
 	}
 
-	return MachinesResponse{
+	out := MachinesResponse{
 		Data:         wrapMachinesData(parsed.JSON200.Data),
 		ResponseMeta: meta,
-	}, nil
+	}
+	if err := fieldmask.Prune(out.Data, q.fields); err != nil {
+		return MachinesResponse{ResponseMeta: meta}, err
+	}
+	return out, nil
 }
 
 func wrapMachinesData(items []v5Client.MachinesItem) MachinesDataItems {
@@ -513,3 +564,210 @@ func (q *MachineQuery) First(ctx context.Context) (MachinesResponse, error) {
 		ResponseMeta: resp.ResponseMeta,
 	}, nil
 }
+
+type ActivityQuery struct {
+	client  service.Client
+	id      int
+	page    int
+	perPage int
+	limit   int
+}
+
+type ActivityPageResponse struct {
+	Data         []ActivityItem
+	Pagination   PagingMeta
+	ResponseMeta common.ResponseMeta
+}
+
+// RecentActivity creates a paginated query over the machine's recent own
+// events (user owns, root owns and bloods), newest first.
+// This is the per-machine feed, not the global activity feed.
+//
+// The endpoint returns the whole recent feed in a single response, so Limit,
+// Page and PerPage are applied client-side after one request.
+//
+// Example:
+//
+//	activity, err := client.Machines.Machine(12345).RecentActivity().Limit(50).PerPage(10).Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, a := range activity.Data {
+//		fmt.Printf("%s owned %s (%s)\n", a.UserName, a.Type, a.DateDiff)
+//	}
+func (h *Handle) RecentActivity() *ActivityQuery {
+	return &ActivityQuery{
+		client:  h.client,
+		id:      h.id,
+		page:    1,
+		perPage: 20,
+	}
+}
+
+// Limit caps the total number of events considered across all pages.
+// Zero or a negative value means no limit.
+//
+// Example:
+//
+//	activity, err := query.Limit(25).AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Events: %d\n", len(activity.Data))
+func (q *ActivityQuery) Limit(n int) *ActivityQuery {
+	qc := ptr.Clone(q)
+	qc.limit = n
+	return qc
+}
+
+// Page sets the page number to return.
+//
+// Example:
+//
+//	activity, err := query.Page(2).Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Page 2 events: %d\n", len(activity.Data))
+func (q *ActivityQuery) Page(n int) *ActivityQuery {
+	qc := ptr.Clone(q)
+	qc.page = n
+	return qc
+}
+
+// PerPage sets the number of events per page.
+//
+// Example:
+//
+//	activity, err := query.PerPage(50).Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Events on page: %d\n", len(activity.Data))
+func (q *ActivityQuery) PerPage(n int) *ActivityQuery {
+	qc := ptr.Clone(q)
+	qc.perPage = n
+	return qc
+}
+
+// Next moves to the next page.
+//
+// Example:
+//
+//	activity, err := query.Next().Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Next page events: %d\n", len(activity.Data))
+func (q *ActivityQuery) Next() *ActivityQuery {
+	qc := ptr.Clone(q)
+	qc.page++
+	return qc
+}
+
+// Previous moves to the previous page. If already on the first page, it remains on page 1.
+//
+// Example:
+//
+//	activity, err := query.Previous().Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Previous page events: %d\n", len(activity.Data))
+func (q *ActivityQuery) Previous() *ActivityQuery {
+	qc := ptr.Clone(q)
+	if qc.page > 1 {
+		qc.page--
+	}
+	return qc
+}
+
+// Results executes the query and returns the current page of events.
+//
+// Example:
+//
+//	activity, err := client.Machines.Machine(12345).RecentActivity().Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Events: %d of %d\n", len(activity.Data), activity.Pagination.Total)
+func (q *ActivityQuery) Results(ctx context.Context) (ActivityPageResponse, error) {
+	all, err := q.fetchAll(ctx)
+	if err != nil {
+		return ActivityPageResponse{ResponseMeta: all.ResponseMeta}, err
+	}
+
+	perPage := q.perPage
+	if perPage <= 0 {
+		perPage = len(all.Data)
+	}
+	page := q.page
+	if page < 1 {
+		page = 1
+	}
+
+	total := len(all.Data)
+	totalPages := 0
+	if perPage > 0 {
+		totalPages = (total + perPage - 1) / perPage
+	}
+
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	return ActivityPageResponse{
+		Data: all.Data[start:end],
+		Pagination: PagingMeta{
+			CurrentPage: page,
+			PerPage:     perPage,
+			Total:       total,
+			TotalPages:  totalPages,
+			Count:       end - start,
+		},
+		ResponseMeta: all.ResponseMeta,
+	}, nil
+}
+
+// AllResults executes the query and returns every event up to the limit.
+//
+// Example:
+//
+//	activity, err := client.Machines.Machine(12345).RecentActivity().Limit(100).AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Events: %d\n", len(activity.Data))
+func (q *ActivityQuery) AllResults(ctx context.Context) (ActivityPageResponse, error) {
+	all, err := q.fetchAll(ctx)
+	if err != nil {
+		return ActivityPageResponse{ResponseMeta: all.ResponseMeta}, err
+	}
+	return ActivityPageResponse{
+		Data: all.Data,
+		Pagination: PagingMeta{
+			CurrentPage: 1,
+			PerPage:     len(all.Data),
+			Total:       len(all.Data),
+			TotalPages:  1,
+			Count:       len(all.Data),
+		},
+		ResponseMeta: all.ResponseMeta,
+	}, nil
+}
+
+func (q *ActivityQuery) fetchAll(ctx context.Context) (ActivityResponse, error) {
+	resp, err := (&Handle{client: q.client, id: q.id}).Activity(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if q.limit > 0 && len(resp.Data) > q.limit {
+		resp.Data = resp.Data[:q.limit]
+	}
+	return resp, nil
+}
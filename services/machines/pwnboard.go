@@ -0,0 +1,157 @@
+package machines
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// PwnEvent is one user or root own on the pwnboard.
+type PwnEvent struct {
+	MachineID   int
+	MachineName string
+	UserID      int
+	Username    string
+	// FlagType is "user" or "root".
+	FlagType   string
+	Blood      bool
+	OccurredAt time.Time
+}
+
+type PwnboardResponse struct {
+	Data         []PwnEvent
+	ResponseMeta common.ResponseMeta
+}
+
+const defaultPwnboardPageSize = 20
+
+// Pwnboard returns one page of recent owns across all active machines,
+// newest first. page starts at 1; perPage of zero or less defaults to 20.
+//
+// The API has no global solve feed, so the pwnboard is assembled from the
+// recent activity of each active machine: one request for the machine list
+// plus one per active machine, made with bounded parallelism. Each call
+// rebuilds the whole board, so walking many pages repeats those requests;
+// a large perPage returns everything at once.
+//
+// Example:
+//
+//	board, err := client.Machines.Pwnboard(ctx, 1, 25)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, e := range board.Data {
+//		fmt.Printf("%s %s owned %s on %s\n", e.OccurredAt.Format(time.Kitchen), e.Username, e.FlagType, e.MachineName)
+//	}
+func (s *Service) Pwnboard(ctx context.Context, page, perPage int) (PwnboardResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = defaultPwnboardPageSize
+	}
+
+	active, err := s.List().ByState("active").AllResults(ctx)
+	if err != nil {
+		return PwnboardResponse{ResponseMeta: active.ResponseMeta}, err
+	}
+
+	feeds, err := batch.Collect(ctx, len(active.Data), batch.DefaultConcurrency, func(ctx context.Context, i int) ([]PwnEvent, error) {
+		m := active.Data[i]
+		activity, err := s.Machine(m.Id).Activity(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("machine %d: %w", m.Id, err)
+		}
+		return pwnEvents(m.Id, m.Name, activity.Data), nil
+	})
+	if err != nil {
+		return PwnboardResponse{ResponseMeta: active.ResponseMeta}, err
+	}
+	if err := feeds.Err(); err != nil {
+		return PwnboardResponse{ResponseMeta: active.ResponseMeta}, err
+	}
+
+	events := make([]PwnEvent, 0)
+	for i := range active.Data {
+		events = append(events, feeds.Succeeded[i]...)
+	}
+	sortNewestFirst(events)
+
+	start := min((page-1)*perPage, len(events))
+	end := min(start+perPage, len(events))
+	return PwnboardResponse{
+		Data:         events[start:end],
+		ResponseMeta: active.ResponseMeta,
+	}, nil
+}
+
+// PwnboardFor returns the most recent owns of one machine, newest first.
+// limit of zero or less returns the whole recent feed. The machine info is
+// fetched as well for its name.
+//
+// Example:
+//
+//	board, err := client.Machines.PwnboardFor(ctx, 12345, 10)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, e := range board.Data {
+//		fmt.Printf("%s owned %s\n", e.Username, e.FlagType)
+//	}
+func (s *Service) PwnboardFor(ctx context.Context, machineID int, limit int) (PwnboardResponse, error) {
+	h := s.Machine(machineID)
+	info, err := h.Info(ctx)
+	if err != nil {
+		return PwnboardResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	activity, err := h.Activity(ctx)
+	if err != nil {
+		return PwnboardResponse{ResponseMeta: activity.ResponseMeta}, err
+	}
+
+	events := pwnEvents(machineID, info.Data.Name, activity.Data)
+	sortNewestFirst(events)
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return PwnboardResponse{
+		Data:         events,
+		ResponseMeta: activity.ResponseMeta,
+	}, nil
+}
+
+// pwnEvents converts a machine's activity feed, keeping user and root owns
+// with a readable timestamp.
+func pwnEvents(machineID int, machineName string, items []ActivityItem) []PwnEvent {
+	events := make([]PwnEvent, 0, len(items))
+	for _, a := range items {
+		if a.Type != "user" && a.Type != "root" {
+			continue
+		}
+		at := parseActivityTime(a.CreatedAt, a.Date)
+		if at == nil {
+			continue
+		}
+		events = append(events, PwnEvent{
+			MachineID:   machineID,
+			MachineName: machineName,
+			UserID:      a.UserId,
+			Username:    a.UserName,
+			FlagType:    a.Type,
+			Blood:       a.BloodType != "",
+			OccurredAt:  *at,
+		})
+	}
+	return events
+}
+
+func sortNewestFirst(events []PwnEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].OccurredAt.After(events[j].OccurredAt)
+	})
+}
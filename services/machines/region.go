@@ -0,0 +1,105 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/vpn"
+)
+
+// ErrInvalidRegion is returned by Spawn when the requested region is not
+// one of the locations offered by the labs VPN servers, or when every
+// server in it is full.
+type ErrInvalidRegion struct {
+	Region string
+	// Available lists the locations that have a server with room.
+	Available []string
+}
+
+func (e *ErrInvalidRegion) Error() string {
+	return fmt.Sprintf("invalid region %q: available regions are %s", e.Region, strings.Join(e.Available, ", "))
+}
+
+// ErrRegionMismatch is wrapped by the error Spawn returns when Region asks
+// for a location other than that of the assigned labs VPN server and
+// WithSwitchVPN was not given.
+var ErrRegionMismatch = errors.New("assigned VPN server is in another region")
+
+type spawnOptions struct {
+	region    string
+	switchVPN bool
+}
+
+// SpawnOption configures Spawn.
+type SpawnOption func(*spawnOptions)
+
+// Region pins the spawned machine to a datacenter location, such as "US"
+// or "EU", as listed by the labs VPN servers.
+func Region(name string) SpawnOption {
+	return func(o *spawnOptions) {
+		o.region = strings.TrimSpace(name)
+	}
+}
+
+// WithSwitchVPN lets Spawn switch the account's labs VPN server to one in
+// the Region location when the assigned server is elsewhere. The switch
+// drops any open VPN connection on the account.
+func WithSwitchVPN() SpawnOption {
+	return func(o *spawnOptions) {
+		o.switchVPN = true
+	}
+}
+
+// switchRegion makes sure the account's labs VPN server is in region. If
+// it is not, it switches to the least busy server there when switchVPN is
+// set and fails with ErrRegionMismatch otherwise. Machines spawn in the
+// location of the assigned VPN server, so this decides where the machine
+// runs.
+func (h *Handle) switchRegion(ctx context.Context, region string, switchVPN bool) (common.ResponseMeta, error) {
+	vpnService := vpn.NewService(h.client)
+	servers, err := vpnService.Servers("labs").Results(ctx)
+	if err != nil {
+		return servers.ResponseMeta, err
+	}
+
+	if strings.EqualFold(servers.Data.Assigned.Location, region) {
+		return servers.ResponseMeta, nil
+	}
+
+	available := map[string]bool{}
+	var candidates vpn.OptionsServers
+	for _, s := range servers.Data.Options {
+		if s.Full {
+			continue
+		}
+		available[s.Location] = true
+		if strings.EqualFold(s.Location, region) {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(candidates) == 0 {
+		regions := make([]string, 0, len(available))
+		for r := range available {
+			regions = append(regions, r)
+		}
+		sort.Strings(regions)
+		return servers.ResponseMeta, &ErrInvalidRegion{Region: region, Available: regions}
+	}
+
+	if !switchVPN {
+		assigned := servers.Data.Assigned
+		return servers.ResponseMeta, fmt.Errorf("%w: %s is in %s, not %s; use WithSwitchVPN to switch",
+			ErrRegionMismatch, assigned.FriendlyName, assigned.Location, region)
+	}
+
+	target := candidates.SortByCurrentClients().First()
+	switched, err := vpnService.VPN(target.Id).Switch(ctx)
+	if err != nil {
+		return switched.ResponseMeta, fmt.Errorf("switch to VPN server %s: %w", target.FriendlyName, err)
+	}
+	return switched.ResponseMeta, nil
+}
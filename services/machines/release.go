@@ -0,0 +1,83 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/ptr"
+)
+
+// ListByReleaseDateRange retrieves active and retired machines whose release
+// date falls within [from, to], sorted by release date ascending.
+// A zero from starts at the first machine ever released; a zero to ends at
+// the current time.
+//
+// Machines are listed newest first and paging stops once a page reaches
+// machines released before from, so narrow recent windows need few requests.
+//
+// Example:
+//
+//	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+//	to := time.Date(2020, 12, 31, 23, 59, 59, 0, time.UTC)
+//	machines, err := client.Machines.ListByReleaseDateRange(ctx, from, to)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range machines.Data {
+//		fmt.Printf("%s released %s\n", m.Name, m.ReleaseDate.Format("2006-01-02"))
+//	}
+func (s *Service) ListByReleaseDateRange(ctx context.Context, from, to time.Time) (MachinesResponse, error) {
+	if to.IsZero() {
+		to = clock.From(s.base.Client).Now()
+	}
+	if !from.IsZero() && from.After(to) {
+		return MachinesResponse{}, errors.New("from must not be after to")
+	}
+
+	q := s.List().
+		ByStateList("active", "retired").
+		sort(v5Client.GetMachinesParamsSortByReleaseDate, v5Client.GetMachinesParamsSortType("desc"))
+
+	var out MachinesDataItems
+	var meta common.ResponseMeta
+
+	for {
+		resp, err := q.fetchResults(ctx)
+		if err != nil {
+			return MachinesResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+		meta = resp.ResponseMeta
+
+		reachedStart := false
+		for _, m := range resp.Data {
+			if m.ReleaseDate.After(to) {
+				continue
+			}
+			if !from.IsZero() && m.ReleaseDate.Before(from) {
+				reachedStart = true
+				continue
+			}
+			out = append(out, m)
+		}
+
+		if reachedStart || len(resp.Data) < q.perPage {
+			break
+		}
+		q = ptr.Clone(q)
+		q.page++
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].ReleaseDate.Before(out[j].ReleaseDate)
+	})
+
+	return MachinesResponse{
+		Data:         out,
+		ResponseMeta: meta,
+	}, nil
+}
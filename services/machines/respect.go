@@ -0,0 +1,96 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// RespectOutcome is what RespectMakers did for one maker.
+type RespectOutcome string
+
+const (
+	RespectGiven   RespectOutcome = "given"
+	RespectSkipped RespectOutcome = "already respected"
+	RespectFailed  RespectOutcome = "failed"
+)
+
+// MakerRespect is the outcome of respecting one maker. Err is set when
+// Outcome is RespectFailed.
+type MakerRespect struct {
+	UserID  int
+	Name    string
+	Outcome RespectOutcome
+	Err     error
+}
+
+type RespectMakersResponse struct {
+	Data         []MakerRespect
+	ResponseMeta common.ResponseMeta
+}
+
+// RespectMakers gives respect to the machine's creator and co-creators,
+// skipping any the authenticated user has already respected, so calling it
+// again is harmless. Makers are respected one at a time, and a failure for
+// one maker does not stop the others; the returned error joins the
+// per-maker failures. Only a failure to list the makers leaves Data empty.
+//
+// Example:
+//
+//	result, err := client.Machines.Machine(12345).RespectMakers(ctx)
+//	for _, r := range result.Data {
+//		fmt.Printf("%s: %s\n", r.Name, r.Outcome)
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) RespectMakers(ctx context.Context) (RespectMakersResponse, error) {
+	creators, err := h.Creators(ctx)
+	if err != nil {
+		return RespectMakersResponse{ResponseMeta: creators.ResponseMeta}, err
+	}
+
+	u := users.NewService(h.client)
+	meta := creators.ResponseMeta
+	seen := map[int]bool{}
+	results := []MakerRespect{}
+	var errs []error
+	for _, maker := range slices.Concat(creators.Data.Creator, creators.Data.Cocreators) {
+		if maker.Id == 0 || seen[maker.Id] {
+			continue
+		}
+		seen[maker.Id] = true
+
+		r := MakerRespect{UserID: maker.Id, Name: maker.Name}
+		if maker.IsRespected {
+			r.Outcome = RespectSkipped
+			results = append(results, r)
+			continue
+		}
+		resp, err := u.User(maker.Id).Respect(ctx)
+		if resp.ResponseMeta.StatusCode != 0 {
+			meta = resp.ResponseMeta
+		}
+		switch {
+		case err != nil:
+			r.Outcome, r.Err = RespectFailed, err
+		case !resp.Data.Success:
+			r.Outcome, r.Err = RespectFailed, fmt.Errorf("respect refused: %s", resp.Data.Message)
+		default:
+			r.Outcome = RespectGiven
+		}
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("maker %s (%d): %w", maker.Name, maker.Id, r.Err))
+		}
+		results = append(results, r)
+	}
+
+	return RespectMakersResponse{
+		Data:         results,
+		ResponseMeta: meta,
+	}, errors.Join(errs...)
+}
@@ -0,0 +1,70 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/seasons"
+)
+
+// ErrNotInSeason is returned by SeasonMembership when the machine is not
+// part of the current season, or no season is running.
+var ErrNotInSeason = errors.New("machine is not in the current season")
+
+type SeasonMembershipResponse struct {
+	// Data is the ID of the season the machine belongs to.
+	Data         int
+	ResponseMeta common.ResponseMeta
+}
+
+// SeasonMembership reports which season the machine currently belongs to,
+// by looking it up in the active season's machine list. It returns
+// ErrNotInSeason if the machine is not seasonal or no season is active.
+// Past seasons are not considered, since the API only lists the machines of
+// the active one.
+//
+// Example:
+//
+//	membership, err := client.Machines.Machine(12345).SeasonMembership(ctx)
+//	switch {
+//	case errors.Is(err, machines.ErrNotInSeason):
+//		fmt.Println("Standard machine")
+//	case err != nil:
+//		log.Fatal(err)
+//	default:
+//		fmt.Printf("Part of season %d\n", membership.Data)
+//	}
+func (h *Handle) SeasonMembership(ctx context.Context) (SeasonMembershipResponse, error) {
+	s := seasons.NewService(h.client)
+	list, err := s.List(ctx)
+	if err != nil {
+		return SeasonMembershipResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+
+	seasonID := 0
+	for _, season := range list.Data {
+		if season.Active {
+			seasonID = season.Id
+			break
+		}
+	}
+	if seasonID == 0 {
+		return SeasonMembershipResponse{ResponseMeta: list.ResponseMeta}, fmt.Errorf("machine %d: %w", h.id, ErrNotInSeason)
+	}
+
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return SeasonMembershipResponse{ResponseMeta: machines.ResponseMeta}, err
+	}
+	for _, m := range machines.Data {
+		if m.Id == h.id {
+			return SeasonMembershipResponse{
+				Data:         seasonID,
+				ResponseMeta: machines.ResponseMeta,
+			}, nil
+		}
+	}
+	return SeasonMembershipResponse{ResponseMeta: machines.ResponseMeta}, fmt.Errorf("machine %d: %w", h.id, ErrNotInSeason)
+}
@@ -5,12 +5,16 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/humanize"
 	"github.com/gubarz/gohtb/internal/common"
 	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/extract"
+	"github.com/gubarz/gohtb/internal/flagutil"
 	"github.com/gubarz/gohtb/internal/service"
 	"github.com/gubarz/gohtb/services/vms"
 )
@@ -30,6 +34,12 @@ type MachineData struct {
 	FeedbackForChart v4Client.DifficultyChart1
 }
 
+// ReleasedAgo describes the machine's release time relative to now, such as
+// "3 days ago", or "in 2 days" for a scheduled release.
+func (m MachineData) ReleasedAgo(now time.Time) string {
+	return humanize.Relative(m.Release, now)
+}
+
 type MachineDataItems []MachineData
 
 type MachinePaginatedResponse struct {
@@ -107,6 +117,8 @@ type Handle struct {
 	id      int
 	name    string
 	product string
+	// info holds the most recent successful Info result.
+	info atomic.Pointer[MachineProfileInfo]
 }
 
 // Machine returns a handle for a specific machine with the given ID.
@@ -151,6 +163,12 @@ type MachineProfileInfo struct {
 	FeedbackForChart DifficultyChart
 }
 
+// ReleasedAgo describes the machine's release time relative to now, such as
+// "3 days ago", or "in 2 days" for a scheduled release.
+func (m MachineProfileInfo) ReleasedAgo(now time.Time) string {
+	return humanize.Relative(m.Release, now)
+}
+
 type InfoResponse struct {
 	Data         MachineProfileInfo
 	ResponseMeta common.ResponseMeta
@@ -159,6 +177,7 @@ type InfoResponse struct {
 // Info retrieves detailed information about the machine.
 // This includes comprehensive machine details such as name, difficulty,
 // operating system, release date, and other metadata.
+// A successful result is kept on the handle for OSHint.
 //
 // Example:
 //
@@ -188,6 +207,7 @@ func (h *Handle) Info(ctx context.Context) (InfoResponse, error) {
 	wrapped := wrapMachineProfileInfo(parsed.JSON200.Info)
 	wrapped.IsAssumedBreach, wrapped.Credentials = parseAssumedBreachStatus(wrapped.InfoStatus)
 	wrapped.FeedbackForChart = feedbackForChart(wrapped.MachineProfileInfo.FeedbackForChart)
+	h.info.Store(&wrapped)
 
 	return InfoResponse{
 		Data:         wrapped,
@@ -195,6 +215,29 @@ func (h *Handle) Info(ctx context.Context) (InfoResponse, error) {
 	}, nil
 }
 
+// OSHint returns the machine's operating system. If Info has already
+// succeeded on this handle, the cached result is used and no request is
+// made; otherwise the machine profile is fetched once and cached.
+//
+// Example:
+//
+//	machine := client.Machines.Machine(12345)
+//	os, err := machine.OSHint(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(os)
+func (h *Handle) OSHint(ctx context.Context) (string, error) {
+	if info := h.info.Load(); info != nil {
+		return info.Os, nil
+	}
+	info, err := h.Info(ctx)
+	if err != nil {
+		return "", err
+	}
+	return info.Data.Os, nil
+}
+
 type MachineOwnResponse = v5Client.MachineOwnResponse
 
 type OwnResponse struct {
@@ -205,6 +248,17 @@ type OwnResponse struct {
 // Own submits a flag for the machine to claim ownership.
 // This is used to submit user or root flags for machines to mark completion.
 //
+// The submission is never retried blindly. If it fails in a way that
+// leaves its outcome unknown (a timeout, a dropped connection or a 5xx
+// response), Own fetches the machine info to check the caller's own status.
+// When Info has already succeeded on this handle, the own status it cached
+// is taken as the state before the submission: if a flag the caller did
+// not own then is now owned, the submission landed, the flag is not sent
+// again and a successful response saying so is returned. Without a cached
+// Info, only a fully owned machine skips the second submission. Otherwise
+// the flag is submitted once more; a flag that did land then comes back as
+// already owned. No request is made before the submission.
+//
 // Example:
 //
 //	result, err := client.Machines.Machine(12345).Own(ctx, "60b725f10c9c85c70d97880dfe8191b3")
@@ -213,7 +267,50 @@ type OwnResponse struct {
 //	}
 //	fmt.Printf("Flag submission: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Own(ctx context.Context, flag string) (OwnResponse, error) {
-	resp, err := h.client.V5().PostMachineOwnWithFormdataBody(h.client.Limiter().Wrap(ctx),
+	flag, err := flagutil.Normalize(h.client, flag, flagutil.Hash)
+	if err != nil {
+		return OwnResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
+
+	before := h.info.Load()
+
+	result, err := h.submitOwn(ctx, flag)
+	if !flagutil.Uncertain(ctx, err) {
+		return result, err
+	}
+
+	after, infoErr := h.Info(ctx)
+	if infoErr != nil {
+		return result, err
+	}
+	userOwned, rootOwned := after.Data.AuthUserInUserOwns, after.Data.AuthUserInRootOwns
+	var landed bool
+	var ownType v5Client.MachineOwnResponseOwnType
+	switch {
+	case before == nil:
+		landed = userOwned && rootOwned
+	case rootOwned && !before.AuthUserInRootOwns:
+		landed, ownType = true, v5Client.MachineOwnResponseOwnTypeRoot
+	case userOwned && !before.AuthUserInUserOwns:
+		landed, ownType = true, v5Client.MachineOwnResponseOwnTypeUser
+	}
+	if !landed {
+		return h.submitOwn(ctx, flag)
+	}
+	return OwnResponse{
+		Data: MachineOwnResponse{
+			Id:           h.id,
+			Success:      true,
+			OwnType:      ownType,
+			MachinePwned: userOwned && rootOwned,
+			Message:      "flag already owned; not resubmitted after an uncertain failure",
+		},
+		ResponseMeta: after.ResponseMeta,
+	}, nil
+}
+
+func (h *Handle) submitOwn(ctx context.Context, flag string) (OwnResponse, error) {
+	resp, err := h.client.V5().PostMachineOwnWithFormdataBody(common.WithoutUnsafeRetry(h.client.Limiter().Wrap(ctx)),
 		v5Client.PostMachineOwnJSONRequestBody{
 			Id:   h.id,
 			Flag: flag,
@@ -227,6 +324,7 @@ func (h *Handle) Own(ctx context.Context, flag string) (OwnResponse, error) {
 	if err != nil {
 		return OwnResponse{ResponseMeta: meta}, err
 	}
+	h.noteOwned(parsed.JSON200.OwnType)
 
 	return OwnResponse{
 		Data:         *parsed.JSON200,
@@ -234,6 +332,25 @@ func (h *Handle) Own(ctx context.Context, flag string) (OwnResponse, error) {
 	}, nil
 }
 
+// noteOwned records an accepted flag in the cached Info result, if any, so
+// that a later Own on the handle compares against the current own status.
+func (h *Handle) noteOwned(ownType v5Client.MachineOwnResponseOwnType) {
+	cached := h.info.Load()
+	if cached == nil {
+		return
+	}
+	updated := *cached
+	switch {
+	case strings.EqualFold(string(ownType), string(v5Client.MachineOwnResponseOwnTypeUser)):
+		updated.AuthUserInUserOwns = true
+	case strings.EqualFold(string(ownType), string(v5Client.MachineOwnResponseOwnTypeRoot)):
+		updated.AuthUserInRootOwns = true
+	default:
+		return
+	}
+	h.info.CompareAndSwap(cached, &updated)
+}
+
 // Reset performs a hard reset of the machine's virtual machine instance.
 // This operation forcefully restarts the VM instance for this machine.
 //
@@ -251,15 +368,29 @@ func (h *Handle) Reset(ctx context.Context) (vms.Response, error) {
 // Extend extends the runtime of the machine's virtual machine instance.
 // This operation prolongs the active session time for the VM.
 //
+// A refused extension is returned as an error wrapping ErrNotSpawned if the
+// machine is not the active instance, or ErrCannotExtend if it is but may
+// not be extended again. Cooldowns and daily limits are reported as
+// *gohtb.ErrCooldown and *gohtb.ErrDailyLimitReached as for other instance
+// operations.
+//
 // Example:
 //
 //	result, err := client.Machines.Machine(12345).Extend(ctx)
+//	if errors.Is(err, machines.ErrCannotExtend) {
+//		fmt.Println("Maximum lifetime reached")
+//		return
+//	}
 //	if err != nil {
 //		log.Fatal(err)
 //	}
 //	fmt.Printf("Extend result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Extend(ctx context.Context) (vms.Response, error) {
-	return vms.NewService(h.client).VM(h.id).Extend(ctx)
+	result, err := vms.NewService(h.client).VM(h.id).Extend(ctx)
+	if err != nil || !result.Data.Success {
+		return result, h.extendError(ctx, err, result.Data.Message)
+	}
+	return result, nil
 }
 
 // Terminate stops and destroys the machine's virtual machine instance.
@@ -279,14 +410,34 @@ func (h *Handle) Terminate(ctx context.Context) (vms.Response, error) {
 // Spawn starts a new instance of the machine's virtual machine.
 // This creates and boots a VM instance for the specified machine.
 //
+// With Region, the region is checked against the labs VPN servers first.
+// Machines run in the location of the assigned VPN server, so if that
+// server is elsewhere Spawn fails with an error wrapping ErrRegionMismatch.
+// Add WithSwitchVPN to switch the account to the least busy server in the
+// region instead; this changes the VPN server for the whole account and
+// drops an open VPN connection. An unknown or full region fails with
+// *ErrInvalidRegion. Nothing is spawned when either error is returned.
+//
 // Example:
 //
-//	result, err := client.Machines.Machine(12345).Spawn(ctx)
+//	result, err := client.Machines.Machine(12345).Spawn(ctx,
+//		machines.Region("EU"),
+//		machines.WithSwitchVPN(),
+//	)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
 //	fmt.Printf("Spawn result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
-func (h *Handle) Spawn(ctx context.Context) (vms.Response, error) {
+func (h *Handle) Spawn(ctx context.Context, opts ...SpawnOption) (vms.Response, error) {
+	var o spawnOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.region != "" {
+		if meta, err := h.switchRegion(ctx, o.region, o.switchVPN); err != nil {
+			return vms.Response{ResponseMeta: meta}, err
+		}
+	}
 	return vms.NewService(h.client).VM(h.id).Spawn(ctx)
 }
 
@@ -360,6 +511,8 @@ type RecommendedMachinesResponse struct {
 }
 
 // Recommended retrieves currently recommended active machines.
+// The API returns two cards for the authenticated user, each labelled by
+// TypeCard. It gives no reason or skill tag for a recommendation.
 //
 // Example:
 //
@@ -1012,6 +1165,41 @@ func (h *Handle) Walkthroughs(ctx context.Context) (WalkthroughsResponse, error)
 	}, nil
 }
 
+type UserWriteup = v4Client.MachineWalkthroughMessageWriteupsItem
+
+type UserWriteupsResponse struct {
+	Data         []UserWriteup
+	ResponseMeta common.ResponseMeta
+}
+
+// UserWriteups lists the community writeups published for the machine, with
+// their author, language and URL. It is the writeups part of Walkthroughs.
+//
+// Example:
+//
+//	writeups, err := client.Machines.Machine(12345).UserWriteups(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, w := range writeups.Data {
+//		fmt.Printf("%s (%s): %s\n", w.UserName, w.LanguageName, w.Url)
+//	}
+func (h *Handle) UserWriteups(ctx context.Context) (UserWriteupsResponse, error) {
+	walkthroughs, err := h.Walkthroughs(ctx)
+	if err != nil {
+		return UserWriteupsResponse{ResponseMeta: walkthroughs.ResponseMeta}, err
+	}
+
+	writeups := walkthroughs.Data.Writeups
+	if writeups == nil {
+		writeups = []UserWriteup{}
+	}
+	return UserWriteupsResponse{
+		Data:         writeups,
+		ResponseMeta: walkthroughs.ResponseMeta,
+	}, nil
+}
+
 type WriteupResponse struct {
 	Data         []byte
 	ResponseMeta common.ResponseMeta
@@ -1041,13 +1229,8 @@ func (h *Handle) Writeup(ctx context.Context) (WriteupResponse, error) {
 	}
 
 	return WriteupResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetMachineWriteup"),
 	}, nil
 }
 
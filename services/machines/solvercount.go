@@ -0,0 +1,48 @@
+package machines
+
+import (
+	"context"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// SolverCounts are the machine's solve totals.
+type SolverCounts struct {
+	UserFlagSolves int
+	RootFlagSolves int
+}
+
+type SolverCountResponse struct {
+	Data         SolverCounts
+	ResponseMeta common.ResponseMeta
+}
+
+// SolverCount returns how many players have submitted the machine's user
+// and root flags. The totals come from the machine profile in a single
+// request, without listing the solvers. Unlike OSHint it always fetches,
+// since the totals change as players solve the machine.
+//
+// The API only reports per-flag totals, so the number of players holding
+// both flags is not available.
+//
+// Example:
+//
+//	counts, err := client.Machines.Machine(12345).SolverCount(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%d user / %d root\n", counts.Data.UserFlagSolves, counts.Data.RootFlagSolves)
+func (h *Handle) SolverCount(ctx context.Context) (SolverCountResponse, error) {
+	info, err := h.Info(ctx)
+	if err != nil {
+		return SolverCountResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	return SolverCountResponse{
+		Data: SolverCounts{
+			UserFlagSolves: info.Data.UserOwnsCount,
+			RootFlagSolves: info.Data.RootOwnsCount,
+		},
+		ResponseMeta: info.ResponseMeta,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package machines
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// SolveEvent counts the flags taken on the machine during one interval
+// starting at Timestamp.
+type SolveEvent struct {
+	Timestamp      time.Time
+	UserFlagSolves int
+	RootFlagSolves int
+}
+
+type SolveAnimationResponse struct {
+	Data         []SolveEvent
+	ResponseMeta common.ResponseMeta
+}
+
+// maxSolveEvents caps the length of a SolveAnimation series.
+const maxSolveEvents = 5000
+
+// SolveAnimation returns the number of user and root flags taken per
+// interval, from the oldest own in the machine's activity feed to now, with
+// empty intervals included. granularity is "hourly", "daily" or "weekly";
+// intervals are aligned to UTC and weeks start on Monday.
+//
+// The activity feed only covers recent owns, so the series starts at its
+// oldest entry rather than at the machine's release; earlier solves are not
+// known. It is empty if the feed has no owns, and holds at most the latest
+// 5000 intervals.
+//
+// Example:
+//
+//	series, err := client.Machines.Machine(12345).SolveAnimation(ctx, "daily")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, e := range series.Data {
+//		fmt.Printf("%s user=%d root=%d\n", e.Timestamp.Format("2006-01-02"), e.UserFlagSolves, e.RootFlagSolves)
+//	}
+func (h *Handle) SolveAnimation(ctx context.Context, granularity string) (SolveAnimationResponse, error) {
+	var truncate func(time.Time) time.Time
+	var step time.Duration
+	switch granularity {
+	case "hourly":
+		truncate = func(t time.Time) time.Time { return t.Truncate(time.Hour) }
+		step = time.Hour
+	case "daily":
+		truncate = truncateDay
+		step = 24 * time.Hour
+	case "weekly":
+		truncate = func(t time.Time) time.Time {
+			d := truncateDay(t)
+			return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+		}
+		step = 7 * 24 * time.Hour
+	default:
+		return SolveAnimationResponse{}, fmt.Errorf("unsupported granularity %q: must be hourly, daily or weekly", granularity)
+	}
+
+	activity, err := h.Activity(ctx)
+	if err != nil {
+		return SolveAnimationResponse{ResponseMeta: activity.ResponseMeta}, err
+	}
+
+	user := map[time.Time]int{}
+	root := map[time.Time]int{}
+	earliest := time.Time{}
+	for _, a := range activity.Data {
+		at := parseActivityTime(a.CreatedAt, a.Date)
+		if at == nil {
+			continue
+		}
+		bucket := truncate(*at)
+		switch a.Type {
+		case "user":
+			user[bucket]++
+		case "root":
+			root[bucket]++
+		default:
+			continue
+		}
+		if earliest.IsZero() || at.Before(earliest) {
+			earliest = *at
+		}
+	}
+
+	end := truncate(clock.From(h.client).Now().UTC())
+	if earliest.IsZero() || end.Before(truncate(earliest)) {
+		return SolveAnimationResponse{Data: []SolveEvent{}, ResponseMeta: activity.ResponseMeta}, nil
+	}
+
+	// Intervals are UTC, so each is exactly step long.
+	start := truncate(earliest)
+	n := int(end.Sub(start)/step) + 1
+	if n > maxSolveEvents {
+		n = maxSolveEvents
+		start = end.Add(-time.Duration(n-1) * step)
+	}
+	series := make([]SolveEvent, n)
+	for i := range series {
+		t := start.Add(time.Duration(i) * step)
+		series[i] = SolveEvent{
+			Timestamp:      t,
+			UserFlagSolves: user[t],
+			RootFlagSolves: root[t],
+		}
+	}
+
+	return SolveAnimationResponse{
+		Data:         series,
+		ResponseMeta: activity.ResponseMeta,
+	}, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,70 @@
+package machines_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSolvesClient(t *testing.T, activity string) *gohtb.Client {
+	t.Helper()
+	srv := gohtbtest.NewServer().JSON("GetMachineActivity", activity)
+	t.Cleanup(srv.Close)
+	clk := gohtb.NewFakeClock(time.Date(2025, 3, 4, 8, 30, 0, 0, time.UTC))
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL), gohtb.WithClock(clk))
+	require.NoError(t, err)
+	return client
+}
+
+func TestSolveAnimationStartsAtOldestFeedEntry(t *testing.T) {
+	client := newSolvesClient(t, `{"info":{"activity":[
+		{"type":"root","created_at":"2025-03-03T10:00:00Z"},
+		{"type":"user","created_at":"2025-03-03T09:00:00Z"},
+		{"type":"user","created_at":"2025-03-01T12:00:00Z"}
+	]}}`)
+
+	series, err := client.Machines.Machine(660).SolveAnimation(context.Background(), "daily")
+	require.NoError(t, err)
+
+	type bucket struct {
+		date       string
+		user, root int
+	}
+	var got []bucket
+	for _, e := range series.Data {
+		got = append(got, bucket{e.Timestamp.Format(time.DateOnly), e.UserFlagSolves, e.RootFlagSolves})
+	}
+	assert.Equal(t, []bucket{
+		{"2025-03-01", 1, 0},
+		{"2025-03-02", 0, 0},
+		{"2025-03-03", 1, 1},
+		{"2025-03-04", 0, 0},
+	}, got)
+}
+
+func TestSolveAnimationCapsIntervals(t *testing.T) {
+	client := newSolvesClient(t, `{"info":{"activity":[
+		{"type":"user","created_at":"2025-03-04T07:15:00Z"},
+		{"type":"user","created_at":"2023-01-01T00:00:00Z"}
+	]}}`)
+
+	series, err := client.Machines.Machine(660).SolveAnimation(context.Background(), "hourly")
+	require.NoError(t, err)
+	require.Len(t, series.Data, 5000)
+	last := series.Data[len(series.Data)-1]
+	assert.Equal(t, time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC), last.Timestamp)
+	assert.Equal(t, 1, series.Data[len(series.Data)-2].UserFlagSolves)
+}
+
+func TestSolveAnimationEmptyFeed(t *testing.T) {
+	client := newSolvesClient(t, `{"info":{"activity":[]}}`)
+
+	series, err := client.Machines.Machine(660).SolveAnimation(context.Background(), "weekly")
+	require.NoError(t, err)
+	assert.Empty(t, series.Data)
+}
@@ -0,0 +1,57 @@
+package machines
+
+import (
+	"context"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// MachineWithStatus is a machine from the list together with the
+// authenticated user's progress on it.
+type MachineWithStatus struct {
+	MachinesData
+	UserOwned bool
+	RootOwned bool
+}
+
+type MachinesWithStatusResponse struct {
+	Data         []MachineWithStatus
+	ResponseMeta common.ResponseMeta
+}
+
+// ListWithSolveStatus returns every active machine with whether the
+// authenticated user has owned its user and root flags. The machine list
+// already reports the caller's owns, so this takes one request per page of
+// the list rather than one per machine.
+//
+// Example:
+//
+//	list, err := client.Machines.ListWithSolveStatus(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range list.Data {
+//		if !m.RootOwned {
+//			fmt.Printf("%s still needs root\n", m.Name)
+//		}
+//	}
+func (s *Service) ListWithSolveStatus(ctx context.Context) (MachinesWithStatusResponse, error) {
+	resp, err := s.List().ByState("active").AllResults(ctx)
+	if err != nil {
+		return MachinesWithStatusResponse{ResponseMeta: resp.ResponseMeta}, err
+	}
+
+	out := make([]MachineWithStatus, len(resp.Data))
+	for i, m := range resp.Data {
+		out[i] = MachineWithStatus{
+			MachinesData: m,
+			UserOwned:    m.AuthUserInUserOwns,
+			RootOwned:    m.AuthUserInRootOwns,
+		}
+	}
+
+	return MachinesWithStatusResponse{
+		Data:         out,
+		ResponseMeta: resp.ResponseMeta,
+	}, nil
+}
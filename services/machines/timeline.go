@@ -0,0 +1,106 @@
+package machines
+
+import (
+	"context"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// OwnershipSnapshot is the number of users holding each flag on the machine
+// at the end of the UTC day starting at Timestamp.
+type OwnershipSnapshot struct {
+	Timestamp       time.Time
+	TotalUserOwners int
+	TotalRootOwners int
+}
+
+type OwnershipTimelineResponse struct {
+	Data         []OwnershipSnapshot
+	ResponseMeta common.ResponseMeta
+}
+
+// OwnershipTimeline returns the machine's cumulative user and root owner
+// counts for every UTC day from the oldest own in its activity feed to the
+// current day.
+//
+// The counts are anchored to the current totals from the machine info and
+// walked back day by day using the activity feed. The feed only covers
+// recent owns, so the series starts at its oldest entry rather than at the
+// machine's release: earlier counts are not known. It is empty if the feed
+// has no owns. The API does not report when a machine retired, so retired
+// machines are also followed to the current day. The machine info is always
+// fetched fresh, since the totals change.
+//
+// Example:
+//
+//	timeline, err := client.Machines.Machine(12345).OwnershipTimeline(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range timeline.Data {
+//		fmt.Printf("%s users=%d roots=%d\n", s.Timestamp.Format("2006-01-02"), s.TotalUserOwners, s.TotalRootOwners)
+//	}
+func (h *Handle) OwnershipTimeline(ctx context.Context) (OwnershipTimelineResponse, error) {
+	info, err := h.Info(ctx)
+	if err != nil {
+		return OwnershipTimelineResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+
+	activity, err := h.Activity(ctx)
+	if err != nil {
+		return OwnershipTimelineResponse{ResponseMeta: activity.ResponseMeta}, err
+	}
+
+	user := map[time.Time]int{}
+	root := map[time.Time]int{}
+	earliest := time.Time{}
+	for _, a := range activity.Data {
+		at := parseActivityTime(a.CreatedAt, a.Date)
+		if at == nil {
+			continue
+		}
+		day := truncateDay(*at)
+		switch a.Type {
+		case "user":
+			user[day]++
+		case "root":
+			root[day]++
+		default:
+			continue
+		}
+		if earliest.IsZero() || at.Before(earliest) {
+			earliest = *at
+		}
+	}
+
+	if earliest.IsZero() {
+		return OwnershipTimelineResponse{Data: []OwnershipSnapshot{}, ResponseMeta: activity.ResponseMeta}, nil
+	}
+
+	first := truncateDay(earliest)
+	today := truncateDay(clock.From(h.client).Now())
+	if today.Before(first) {
+		return OwnershipTimelineResponse{Data: []OwnershipSnapshot{}, ResponseMeta: activity.ResponseMeta}, nil
+	}
+
+	days := int(today.Sub(first).Hours()/24) + 1
+	timeline := make([]OwnershipSnapshot, days)
+	users, roots := info.Data.UserOwnsCount, info.Data.RootOwnsCount
+	for i := days - 1; i >= 0; i-- {
+		day := first.AddDate(0, 0, i)
+		timeline[i] = OwnershipSnapshot{
+			Timestamp:       day,
+			TotalUserOwners: users,
+			TotalRootOwners: roots,
+		}
+		users = max(users-user[day], 0)
+		roots = max(roots-root[day], 0)
+	}
+
+	return OwnershipTimelineResponse{
+		Data:         timeline,
+		ResponseMeta: activity.ResponseMeta,
+	}, nil
+}
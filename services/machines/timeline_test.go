@@ -0,0 +1,60 @@
+package machines_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnershipTimelineStartsAtOldestFeedEntry(t *testing.T) {
+	// Released long before the feed's oldest own, with 50 owners the feed
+	// does not cover.
+	srv := gohtbtest.NewServer().
+		JSON("GetMachineProfile", `{"info":{"id":660,"release":"2024-01-01T00:00:00Z","user_owns_count":53,"root_owns_count":51}}`).
+		JSON("GetMachineActivity", `{"info":{"activity":[
+			{"type":"root","created_at":"2025-03-03T10:00:00Z"},
+			{"type":"user","created_at":"2025-03-03T09:00:00Z"},
+			{"type":"user","created_at":"2025-03-02T18:00:00Z"},
+			{"type":"user","created_at":"2025-03-01T12:00:00Z"}
+		]}}`)
+	defer srv.Close()
+	clk := gohtb.NewFakeClock(time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC))
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL), gohtb.WithClock(clk))
+	require.NoError(t, err)
+
+	timeline, err := client.Machines.Machine(660).OwnershipTimeline(context.Background())
+	require.NoError(t, err)
+
+	type day struct {
+		date        string
+		users, root int
+	}
+	var got []day
+	for _, s := range timeline.Data {
+		got = append(got, day{s.Timestamp.Format(time.DateOnly), s.TotalUserOwners, s.TotalRootOwners})
+	}
+	assert.Equal(t, []day{
+		{"2025-03-01", 51, 50},
+		{"2025-03-02", 52, 50},
+		{"2025-03-03", 53, 51},
+		{"2025-03-04", 53, 51},
+	}, got)
+}
+
+func TestOwnershipTimelineEmptyFeed(t *testing.T) {
+	srv := gohtbtest.NewServer().
+		JSON("GetMachineProfile", `{"info":{"id":660,"release":"2024-01-01T00:00:00Z","user_owns_count":53,"root_owns_count":51}}`).
+		JSON("GetMachineActivity", `{"info":{"activity":[]}}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+
+	timeline, err := client.Machines.Machine(660).OwnershipTimeline(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, timeline.Data)
+}
@@ -5,6 +5,7 @@ import (
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/flagutil"
 	"github.com/gubarz/gohtb/internal/service"
 )
 
@@ -399,6 +400,11 @@ type SubmitFlagResponse struct {
 //	}
 //	fmt.Printf("Submit result: %s\n", result.Data.Message)
 func (h *Handle) SubmitFlag(ctx context.Context, flag string) (SubmitFlagResponse, error) {
+	flag, err := flagutil.Normalize(h.client, flag, flagutil.Prefixed)
+	if err != nil {
+		return SubmitFlagResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
+
 	resp, err := h.client.V4().PostProlabFlag(
 		h.client.Limiter().Wrap(ctx),
 		h.id,
@@ -423,6 +429,69 @@ func (h *Handle) SubmitFlag(ctx context.Context, flag string) (SubmitFlagRespons
 	}, nil
 }
 
+// FlagOutcome is what happened to one flag submitted by SubmitFlags.
+type FlagOutcome = flagutil.Outcome
+
+const (
+	FlagAccepted     = flagutil.Accepted
+	FlagAlreadyOwned = flagutil.AlreadyOwned
+	FlagIncorrect    = flagutil.Incorrect
+	FlagError        = flagutil.Failed
+	// FlagSkipped marks flags that were not submitted because the run
+	// stopped early.
+	FlagSkipped = flagutil.Skipped
+)
+
+// FlagResult is the outcome of one flag submitted by SubmitFlags.
+type FlagResult = flagutil.Result
+
+// FlagResults holds one FlagResult per input flag, in input order.
+type FlagResults = flagutil.Results
+
+// SubmitFlagsOption configures SubmitFlags.
+type SubmitFlagsOption = flagutil.BulkOption
+
+// WithStopOnFirstError makes SubmitFlags stop at the first flag that is not
+// accepted or already owned. By default it only stops on auth errors.
+func WithStopOnFirstError() SubmitFlagsOption {
+	return flagutil.WithStopOnFirstError()
+}
+
+type SubmitFlagsResponse struct {
+	Data FlagResults
+	// ResponseMeta is the metadata of the last submission made.
+	ResponseMeta common.ResponseMeta
+}
+
+// SubmitFlags submits flags for the prolab one at a time, in order, through
+// the rate limiter. Incorrect flags and other failures are recorded and the
+// run continues; an auth error or context cancellation stops it, and the
+// remaining flags are reported as FlagSkipped. The returned error is the
+// cause of such a stop.
+//
+// Example:
+//
+//	results, err := client.Prolabs.Prolab(1).SubmitFlags(ctx, flags)
+//	for _, r := range results.Data {
+//		fmt.Printf("%-40s %-14s %s\n", r.Flag, r.Outcome, r.Message)
+//	}
+//	fmt.Printf("%d accepted\n", results.Data.Count(prolabs.FlagAccepted))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) SubmitFlags(ctx context.Context, flags []string, opts ...SubmitFlagsOption) (SubmitFlagsResponse, error) {
+	var meta common.ResponseMeta
+	results, err := flagutil.SubmitAll(ctx, flags, func(ctx context.Context, flag string) (string, error) {
+		resp, err := h.SubmitFlag(ctx, flag)
+		meta = resp.ResponseMeta
+		return resp.Data.Message, err
+	}, opts...)
+	return SubmitFlagsResponse{
+		Data:         results,
+		ResponseMeta: meta,
+	}, err
+}
+
 // ChangelogsData contains prolab changelog entries.
 type ChangelogsData struct {
 	Data   []map[string]interface{}
@@ -2,13 +2,12 @@ package pwnbox
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/common"
 	"github.com/gubarz/gohtb/internal/errutil"
-	"github.com/gubarz/gohtb/internal/extract"
 	"github.com/gubarz/gohtb/internal/service"
 )
 
@@ -45,6 +44,12 @@ type StartResponse struct {
 //	}
 //	fmt.Printf("Pwnbox start response: %+v\n", start.Data)
 func (s *Service) Start(ctx context.Context) (StartResponse, error) {
+	unlock, wait, err := service.LockInstance(ctx, s.base.Client)
+	if err != nil {
+		return StartResponse{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	resp, err := s.base.Client.V4().PostPwnboxStart(
 		s.base.Client.Limiter().Wrap(ctx),
 		v4Client.PostPwnboxStartJSONRequestBody{},
@@ -54,8 +59,9 @@ func (s *Service) Start(ctx context.Context) (StartResponse, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostPwnboxStartResponse)
+	meta.QueueWait = wait
 	if err != nil {
-		return StartResponse{ResponseMeta: meta}, err
+		return StartResponse{ResponseMeta: meta}, errutil.InstanceLimit(err, meta.Headers, meta.Raw, clock.From(s.base.Client).Now())
 	}
 
 	return StartResponse{Data: *parsed.JSON200, ResponseMeta: meta}, nil
@@ -102,28 +108,26 @@ func (s *Service) Status(ctx context.Context) (StatusResponse, error) {
 //	}
 //	fmt.Printf("Terminate result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (s *Service) Terminate(ctx context.Context) (common.MessageResponse, error) {
+	unlock, wait, err := service.LockInstance(ctx, s.base.Client)
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	resp, err := s.base.Client.V4().PostPwnboxTerminate(s.base.Client.Limiter().Wrap(ctx))
-	raw := extract.Raw(resp)
-	if err != nil || resp == nil {
-		return errutil.UnwrapFailure(err, raw, common.SafeStatus(resp), func(raw []byte) common.MessageResponse {
-			return common.MessageResponse{ResponseMeta: common.ResponseMeta{Raw: raw}}
-		})
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
 	}
 
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return errutil.UnwrapFailure(fmt.Errorf("unexpected status code %d", resp.StatusCode), raw, resp.StatusCode, func(raw []byte) common.MessageResponse {
-			return common.MessageResponse{ResponseMeta: common.ResponseMeta{Raw: raw}}
-		})
+	meta, err := common.ParseEmpty(resp, v4Client.ParsePostPwnboxTerminateResponse, http.StatusOK, http.StatusNoContent)
+	meta.QueueWait = wait
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: meta}, err
 	}
 
 	return common.MessageResponse{
-		Data: common.Message{Message: http.StatusText(resp.StatusCode), Success: true},
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         common.Message{Message: http.StatusText(resp.StatusCode), Success: true},
+		ResponseMeta: meta,
 	}, nil
 }
 
@@ -0,0 +1,98 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+type LeaderboardEntry = v4Client.SeasonPlayersLeaderboardDataItem
+
+type LeaderboardAroundResponse struct {
+	// Data holds the players ranked within radius of the user, best rank first.
+	Data []LeaderboardEntry
+	// UserRank is the user's current rank in the season.
+	UserRank     int
+	ResponseMeta common.ResponseMeta
+}
+
+const leaderboardPageSize = 100
+
+// LeaderboardAround retrieves the season player leaderboard around a user:
+// the user plus up to radius players ranked directly above and below them.
+// A radius of zero or less defaults to 10.
+//
+// The user's rank is looked up first, then only the leaderboard pages that
+// cover the requested window are fetched.
+//
+// Example:
+//
+//	around, err := client.Seasons.Season(7).LeaderboardAround(ctx, 12345, 10)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, p := range around.Data {
+//		fmt.Printf("#%d %s (%d points)\n", p.Rank, p.Name, p.Points)
+//	}
+func (h *Handle) LeaderboardAround(ctx context.Context, userID int, radius int) (LeaderboardAroundResponse, error) {
+	if radius <= 0 {
+		radius = 10
+	}
+
+	end, err := h.End(ctx, userID)
+	if err != nil {
+		return LeaderboardAroundResponse{ResponseMeta: end.ResponseMeta}, err
+	}
+	rank := end.Data.Rank.Current
+	if rank <= 0 {
+		return LeaderboardAroundResponse{ResponseMeta: end.ResponseMeta}, fmt.Errorf("user %d has no rank in season %d", userID, h.id)
+	}
+
+	lo := max(rank-radius, 1)
+	hi := rank + radius
+
+	entries, _, meta, err := h.leaderboardPositions(ctx, lo, hi)
+	if err != nil {
+		return LeaderboardAroundResponse{ResponseMeta: meta}, err
+	}
+
+	return LeaderboardAroundResponse{
+		Data:         entries,
+		UserRank:     rank,
+		ResponseMeta: meta,
+	}, nil
+}
+
+func (h *Handle) leaderboardPage(ctx context.Context, leaderboard LeaderboardType, page, perPage int) (LeaderboardResponse, error) {
+	paramsEditor := func(_ context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(perPage))
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+
+	resp, err := h.client.V4().GetSeasonLeaderboard(
+		h.client.Limiter().Wrap(ctx),
+		leaderboard,
+		&v4Client.GetSeasonLeaderboardParams{Season: strconv.Itoa(h.id)},
+		paramsEditor,
+	)
+	if err != nil {
+		return LeaderboardResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
+
+	parsed, meta, err := common.Parse(resp, v4Client.ParseGetSeasonLeaderboardResponse)
+	if err != nil {
+		return LeaderboardResponse{ResponseMeta: meta}, err
+	}
+
+	return LeaderboardResponse{
+		Data:         *parsed.JSON200,
+		ResponseMeta: meta,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package seasons
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+type SeasonRewardItem = v4Client.SeasonRewardItem
+
+// EligibleReward is a reward tier whose threshold the user has met.
+type EligibleReward struct {
+	// RewardType is the name of the reward category the tier belongs to.
+	RewardType string
+	Tier       string
+	// FlagsNeeded is the number of season flags the tier requires, or 0
+	// when it is awarded by tier name instead.
+	FlagsNeeded int
+	Order       int
+	Rewards     []SeasonRewardItem
+}
+
+type EligibleRewardsResponse struct {
+	Data         []EligibleReward
+	ResponseMeta common.ResponseMeta
+}
+
+// EligibleRewards lists the season's reward tiers the authenticated user has
+// already earned, in tier order.
+//
+// A tier with a flag threshold is earned once the user's season flags reach
+// it. A tier without one is earned when its name matches the user's current
+// league. Tier thresholds come from the reward groups, so no leaderboard
+// lookups are needed.
+//
+// Example:
+//
+//	rewards, err := client.Seasons.Season(7).EligibleRewards(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, r := range rewards.Data {
+//		fmt.Printf("%s: %s (%d rewards)\n", r.RewardType, r.Tier, len(r.Rewards))
+//	}
+func (h *Handle) EligibleRewards(ctx context.Context) (EligibleRewardsResponse, error) {
+	rewards, err := h.Rewards(ctx)
+	if err != nil {
+		return EligibleRewardsResponse{ResponseMeta: rewards.ResponseMeta}, err
+	}
+
+	rank, err := h.UserRank(ctx)
+	if err != nil {
+		return EligibleRewardsResponse{ResponseMeta: rank.ResponseMeta}, err
+	}
+	flags := rank.Data.TotalSeasonFlags.Obtained
+	league := rank.Data.League
+
+	eligible := make([]EligibleReward, 0)
+	for _, item := range rewards.Data {
+		for _, group := range item.RewardTypes.Groups {
+			earned := group.FlagsNeeded > 0 && flags >= group.FlagsNeeded
+			if group.FlagsNeeded == 0 {
+				earned = league != "" && strings.EqualFold(group.Name, league)
+			}
+			if !earned {
+				continue
+			}
+			eligible = append(eligible, EligibleReward{
+				RewardType:  item.RewardTypes.Name,
+				Tier:        group.Name,
+				FlagsNeeded: group.FlagsNeeded,
+				Order:       group.Order,
+				Rewards:     group.Rewards,
+			})
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		if eligible[i].Order != eligible[j].Order {
+			return eligible[i].Order < eligible[j].Order
+		}
+		return eligible[i].FlagsNeeded < eligible[j].FlagsNeeded
+	})
+
+	return EligibleRewardsResponse{
+		Data:         eligible,
+		ResponseMeta: rank.ResponseMeta,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+const (
+	seasonEndMaxPoll        = time.Hour
+	seasonEndInitialBackoff = 2 * time.Second
+	seasonEndMaxBackoff     = 2 * time.Minute
+)
+
+// OnSeasonEnd waits for the season to end and then calls fn once with a
+// final Snapshot of the authenticated user's standing. It returns nil after
+// fn returns, or ctx.Err() if ctx is cancelled first. If the season has
+// already ended, fn is called straight away.
+//
+// The end date is re-read from the season list at least hourly, so an
+// extended season is followed. Seasonal machine ownership is only served
+// while a season is active, so a snapshot is also taken in the last poll
+// before the end and its OwnedMachines are carried into the final one.
+// Transient failures (network errors, 429 and 5xx responses) are retried
+// with exponential backoff; other errors are returned as is.
+//
+// Example:
+//
+//	err := client.Seasons.OnSeasonEnd(ctx, 7, func(final seasons.Snapshot) {
+//		data, _ := json.Marshal(final)
+//		os.WriteFile("season-7-final.json", data, 0o644)
+//	})
+//	if err != nil && !errors.Is(err, context.Canceled) {
+//		log.Fatal(err)
+//	}
+func (s *Service) OnSeasonEnd(ctx context.Context, seasonID int, fn func(final Snapshot)) error {
+	clk := clock.From(s.base.Client)
+	h := s.Season(seasonID)
+
+	var backoff time.Duration
+	retry := func(err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !errutil.Transient(err) {
+			return err
+		}
+		backoff = min(max(backoff*2, seasonEndInitialBackoff), seasonEndMaxBackoff)
+		return clock.Sleep(ctx, clk, backoff)
+	}
+
+	var last []OwnedMachine
+	for {
+		list, err := s.List(ctx)
+		if err != nil {
+			if err := retry(err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var end time.Time
+		found := false
+		for _, season := range list.Data {
+			if season.Id == seasonID {
+				end, found = season.EndDate, true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("season %d not found", seasonID)
+		}
+
+		remaining := end.Sub(clk.Now())
+		if end.IsZero() || remaining > seasonEndMaxPoll {
+			backoff = 0
+			if err := clock.Sleep(ctx, clk, seasonEndMaxPoll); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if remaining > 0 {
+			snap, err := h.Snapshot(ctx)
+			if err != nil {
+				if err := retry(err); err != nil {
+					return err
+				}
+				continue
+			}
+			backoff = 0
+			last = snap.OwnedMachines
+			if err := clock.Sleep(ctx, clk, remaining); err != nil {
+				return err
+			}
+			continue
+		}
+
+		final, err := h.Snapshot(ctx)
+		if err != nil {
+			if err := retry(err); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(final.OwnedMachines) == 0 && len(last) > 0 {
+			final.OwnedMachines = last
+		}
+		fn(final)
+		return nil
+	}
+}
@@ -0,0 +1,171 @@
+package seasons_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/gubarz/gohtb/services/seasons"
+)
+
+// leaderboardPages answers GetSeasonLeaderboard with players ranked 1 to
+// total, paged by the page and per_page query parameters.
+func leaderboardPages(total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		var rows []string
+		for rank := (page-1)*perPage + 1; rank <= min(page*perPage, total); rank++ {
+			rows = append(rows, fmt.Sprintf(`{"rank":%d,"name":"player%d","points":%d}`, rank, rank, 1000-10*rank))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[%s],"meta":{"current_page":%d,"last_page":%d}}`,
+			strings.Join(rows, ","), page, (total+perPage-1)/perPage)
+	}
+}
+
+func ExampleService_List() {
+	srv := gohtbtest.NewServer().
+		JSON("GetSeasonList", `{"data":[{"id":7,"name":"Season 7","active":true},{"id":6,"name":"Season 6"}]}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	list, err := client.Seasons.List(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, season := range list.Data {
+		fmt.Printf("Season: %s (ID: %d, active: %t)\n", season.Name, season.Id, season.Active)
+	}
+	// Output:
+	// Season: Season 7 (ID: 7, active: true)
+	// Season: Season 6 (ID: 6, active: false)
+}
+
+func ExampleHandle_LeaderboardPages() {
+	srv := gohtbtest.NewServer().HandleFunc("GetSeasonLeaderboard", leaderboardPages(5))
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	it := client.Seasons.Season(7).LeaderboardPages(seasons.LeaderboardPlayers, 2)
+	for it.Next(context.Background()) {
+		for _, p := range it.Page() {
+			fmt.Printf("#%d %s %d\n", p.Rank, p.Name, p.Points)
+		}
+	}
+	if err := it.Err(); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// #1 player1 990
+	// #2 player2 980
+	// #3 player3 970
+	// #4 player4 960
+	// #5 player5 950
+}
+
+func ExampleService_ResumeLeaderboard() {
+	srv := gohtbtest.NewServer().HandleFunc("GetSeasonLeaderboard", leaderboardPages(5))
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// Read the first page, then save the position.
+	it := client.Seasons.Season(7).LeaderboardPages(seasons.LeaderboardPlayers, 2)
+	if !it.Next(ctx) {
+		log.Fatal(it.Err())
+	}
+	saved := it.Cursor()
+
+	// Later, possibly in another process, carry on from the second page.
+	resumed, err := client.Seasons.ResumeLeaderboard(saved)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for resumed.Next(ctx) {
+		for _, p := range resumed.Page() {
+			fmt.Printf("#%d %s\n", p.Rank, p.Name)
+		}
+	}
+	if err := resumed.Err(); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// #3 player3
+	// #4 player4
+	// #5 player5
+}
+
+func ExampleHandle_TeamRankings() {
+	srv := gohtbtest.NewServer().
+		JSON("GetSeasonLeaderboard", `{"data":[{"rank":1,"resource_id":10,"name":"Alpha","points":900},{"rank":2,"resource_id":20,"name":"Bravo","points":800}],"meta":{"current_page":1,"last_page":1}}`).
+		JSON("GetTeamMembers", `[{"id":1,"name":"alice"},{"id":2,"name":"bob"}]`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+	season := client.Seasons.Season(7)
+
+	// Rankings alone take a single request.
+	rankings, err := season.TeamRankings(context.Background(), 1, 25)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, t := range rankings.Data {
+		fmt.Printf("#%d %s: %d points\n", t.Rank, t.TeamName, t.Points)
+	}
+
+	// WithMembers adds one request per team for its roster.
+	rankings, err = season.TeamRankings(context.Background(), 1, 25, seasons.WithMembers())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, t := range rankings.Data {
+		fmt.Printf("%s has %d members\n", t.TeamName, len(t.Members))
+	}
+	// Output:
+	// #1 Alpha: 900 points
+	// #2 Bravo: 800 points
+	// Alpha has 2 members
+	// Bravo has 2 members
+}
+
+func ExampleService_Summarize() {
+	srv := gohtbtest.NewServer().
+		JSON("GetSeasonList", `{"data":[{"id":6,"name":"Season 6","players":5}]}`).
+		HandleFunc("GetSeasonLeaderboard", leaderboardPages(5))
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	summary, err := client.Seasons.Summarize(context.Background(), 6, seasons.WithAveragePoints())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s: %d players, %.1f points on average\n", summary.Name, summary.TotalParticipants, summary.AveragePoints)
+	if summary.MachinesReleased == nil {
+		fmt.Println("machine count unknown for a past season")
+	}
+	fmt.Println("winner:", summary.TopUsers[0].Name)
+	// Output:
+	// Season 6: 5 players, 970.0 points on average
+	// machine count unknown for a past season
+	// winner: player1
+}
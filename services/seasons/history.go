@@ -0,0 +1,118 @@
+package seasons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
+)
+
+// SeasonHistoryEntry is a user's final standing in one season.
+type SeasonHistoryEntry struct {
+	SeasonID   int
+	SeasonName string
+	StartDate  time.Time
+	EndDate    time.Time
+	Tier       string
+	Rank       int
+	TotalRanks int
+	// UserFlags and RootFlags count the seasonal flags captured. The
+	// season-end endpoint does not report points.
+	UserFlags  int
+	RootFlags  int
+	UserBloods int
+	RootBloods int
+}
+
+type UserHistoryResponse struct {
+	Data         []SeasonHistoryEntry
+	ResponseMeta common.ResponseMeta
+}
+
+// UserHistory retrieves the user's final tier and rank for every season they
+// took part in, ordered oldest to newest.
+//
+// The history is built from the season list plus one season-end request per
+// season, run with bounded parallelism. Seasons the user did not play (no
+// rank, or not found for the user) are left out. Any other failure is
+// returned as an error so a missing entry always means "did not participate".
+//
+// Example:
+//
+//	history, err := client.Seasons.UserHistory(ctx, 12345)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range history.Data {
+//		fmt.Printf("%s: %s tier, rank %d/%d\n", s.SeasonName, s.Tier, s.Rank, s.TotalRanks)
+//	}
+func (s *Service) UserHistory(ctx context.Context, userID int) (UserHistoryResponse, error) {
+	list, err := s.List(ctx)
+	if err != nil {
+		return UserHistoryResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+
+	// Seasons the user did not play in yield a nil entry.
+	entries, err := batch.Collect(ctx, len(list.Data), batch.DefaultConcurrency, func(ctx context.Context, i int) (*SeasonHistoryEntry, error) {
+		season := list.Data[i]
+		end, err := s.Season(season.Id).End(ctx, userID)
+		if err != nil {
+			if isNotParticipatedError(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("season %d: %w", season.Id, err)
+		}
+		if end.Data.Rank.Current <= 0 {
+			return nil, nil
+		}
+
+		return &SeasonHistoryEntry{
+			SeasonID:   season.Id,
+			SeasonName: season.Name,
+			StartDate:  season.StartDate,
+			EndDate:    season.EndDate,
+			Tier:       end.Data.Season.Tier,
+			Rank:       end.Data.Rank.Current,
+			TotalRanks: end.Data.Rank.Total,
+			UserFlags:  end.Data.Owns.User.FlagsPawned,
+			RootFlags:  end.Data.Owns.Root.FlagsPawned,
+			UserBloods: end.Data.Owns.User.BloodsObtained,
+			RootBloods: end.Data.Owns.Root.BloodsObtained,
+		}, nil
+	})
+	if err != nil {
+		return UserHistoryResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	if err := entries.Err(); err != nil {
+		return UserHistoryResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+
+	out := make([]SeasonHistoryEntry, 0, len(entries.Succeeded))
+	for _, e := range entries.Succeeded {
+		if e != nil {
+			out = append(out, *e)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].StartDate.Equal(out[j].StartDate) {
+			return out[i].StartDate.Before(out[j].StartDate)
+		}
+		return out[i].SeasonID < out[j].SeasonID
+	})
+
+	return UserHistoryResponse{
+		Data:         out,
+		ResponseMeta: list.ResponseMeta,
+	}, nil
+}
+
+func isNotParticipatedError(err error) bool {
+	var apiErr *errutil.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
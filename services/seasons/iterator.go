@@ -0,0 +1,155 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/cursor"
+)
+
+// ErrInvalidCursor is wrapped by the error ResumeLeaderboard returns for a
+// cursor it cannot resume: one from another operation, with unknown or
+// invalid filters, or in an older format.
+var ErrInvalidCursor = cursor.ErrInvalid
+
+const leaderboardOperation = "GetSeasonLeaderboard"
+
+type leaderboardFilters struct {
+	Season      int             `json:"season"`
+	Leaderboard LeaderboardType `json:"leaderboard"`
+	PerPage     int             `json:"per_page"`
+}
+
+// LeaderboardIterator walks a season leaderboard one page at a time. Its
+// position can be saved with Cursor and restored in another process with
+// Service.ResumeLeaderboard.
+type LeaderboardIterator struct {
+	h       *Handle
+	filters leaderboardFilters
+	next    int
+	page    []LeaderboardEntry
+	meta    common.ResponseMeta
+	done    bool
+	err     error
+}
+
+// LeaderboardPages returns an iterator over the season's player or team
+// leaderboard, perPage entries at a time. A perPage of zero or less
+// defaults to 100.
+//
+// Example:
+//
+//	it := client.Seasons.Season(7).LeaderboardPages(seasons.LeaderboardPlayers, 100)
+//	for it.Next(ctx) {
+//		for _, p := range it.Page() {
+//			fmt.Printf("#%d %s\n", p.Rank, p.Name)
+//		}
+//		os.WriteFile("cursor", it.Cursor(), 0o644)
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) LeaderboardPages(leaderboard LeaderboardType, perPage int) *LeaderboardIterator {
+	if leaderboard == "" {
+		leaderboard = LeaderboardPlayers
+	}
+	if perPage <= 0 {
+		perPage = leaderboardPageSize
+	}
+	return &LeaderboardIterator{
+		h: h,
+		filters: leaderboardFilters{
+			Season:      h.id,
+			Leaderboard: leaderboard,
+			PerPage:     perPage,
+		},
+		next: 1,
+	}
+}
+
+// ResumeLeaderboard rebuilds a LeaderboardIterator from a cursor returned by
+// LeaderboardIterator.Cursor, so the next call to Next fetches the page after
+// the last one the earlier iterator returned. Cursors from other operations,
+// with filters this version does not understand, or in another format
+// version are rejected with an error wrapping ErrInvalidCursor.
+//
+// Example:
+//
+//	saved, err := os.ReadFile("cursor")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	it, err := client.Seasons.ResumeLeaderboard(saved)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for it.Next(ctx) {
+//		// ...
+//	}
+func (s *Service) ResumeLeaderboard(c []byte) (*LeaderboardIterator, error) {
+	var f leaderboardFilters
+	next, err := cursor.Decode(c, leaderboardOperation, &f)
+	if err != nil {
+		return nil, err
+	}
+	if f.Season <= 0 || f.PerPage <= 0 {
+		return nil, fmt.Errorf("%w: season %d, per_page %d", ErrInvalidCursor, f.Season, f.PerPage)
+	}
+	if f.Leaderboard != LeaderboardPlayers && f.Leaderboard != LeaderboardTeams {
+		return nil, fmt.Errorf("%w: unknown leaderboard %q", ErrInvalidCursor, f.Leaderboard)
+	}
+	return &LeaderboardIterator{
+		h:       s.Season(f.Season),
+		filters: f,
+		next:    next,
+	}, nil
+}
+
+// Next fetches the next page. It returns false once the leaderboard is
+// exhausted or a request fails; Err distinguishes the two.
+func (it *LeaderboardIterator) Next(ctx context.Context) bool {
+	if it.done {
+		return false
+	}
+	resp, err := it.h.leaderboardPage(ctx, it.filters.Leaderboard, it.next, it.filters.PerPage)
+	it.meta = resp.ResponseMeta
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+	it.page = resp.Data.Data
+	if len(it.page) == 0 {
+		it.done = true
+		return false
+	}
+	if last := resp.Data.Meta.LastPage; last > 0 && it.next >= last {
+		it.done = true
+	}
+	it.next++
+	return true
+}
+
+// Page returns the entries fetched by the last successful call to Next.
+func (it *LeaderboardIterator) Page() []LeaderboardEntry {
+	return it.page
+}
+
+// ResponseMeta returns the metadata of the last request.
+func (it *LeaderboardIterator) ResponseMeta() common.ResponseMeta {
+	return it.meta
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *LeaderboardIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns an opaque, versioned encoding of the iterator's operation,
+// filters and next page. Save it after processing a page to resume from the
+// following one.
+func (it *LeaderboardIterator) Cursor() []byte {
+	c, _ := cursor.Encode(leaderboardOperation, it.filters, it.next)
+	return c
+}
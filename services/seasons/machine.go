@@ -0,0 +1,123 @@
+package seasons
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/service"
+)
+
+type MachineHandle struct {
+	client service.Client
+	id     int
+}
+
+// Machine returns a handle for a seasonal machine with the given ID.
+//
+// Example:
+//
+//	machine := client.Seasons.Machine(601)
+//	_ = machine
+func (s *Service) Machine(id int) *MachineHandle {
+	return &MachineHandle{
+		client: s.base.Client,
+		id:     id,
+	}
+}
+
+// BloodStatus reports whether a first blood has been claimed.
+type BloodStatus string
+
+const (
+	BloodOpen    BloodStatus = "open"
+	BloodClaimed BloodStatus = "claimed"
+)
+
+// FirstBlood describes a single first blood. When Status is BloodOpen all
+// other fields are zero.
+type FirstBlood struct {
+	Status   BloodStatus
+	UserID   int
+	Username string
+	TakenAt  time.Time
+	// Elapsed is the time from release to the blood as reported by the API,
+	// for example "00H 21M 47S".
+	Elapsed string
+}
+
+// Open reports whether the first blood is still available.
+func (b FirstBlood) Open() bool {
+	return b.Status == BloodOpen
+}
+
+type FirstBloods struct {
+	User FirstBlood
+	Root FirstBlood
+}
+
+type FirstBloodsResponse struct {
+	Data         FirstBloods
+	ResponseMeta common.ResponseMeta
+}
+
+// FirstBloods retrieves the user and root first bloods for the machine.
+// A blood that has not been taken yet is returned with Status BloodOpen.
+//
+// Example:
+//
+//	bloods, err := client.Seasons.Machine(601).FirstBloods(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if bloods.Data.Root.Open() {
+//		fmt.Println("Root first blood available!")
+//	} else {
+//		fmt.Printf("Root blood: %s at %s\n", bloods.Data.Root.Username, bloods.Data.Root.TakenAt)
+//	}
+func (h *MachineHandle) FirstBloods(ctx context.Context) (FirstBloodsResponse, error) {
+	resp, err := h.client.V4().GetMachineProfile(h.client.Limiter().Wrap(ctx), strconv.Itoa(h.id))
+	if err != nil {
+		return FirstBloodsResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
+
+	parsed, meta, err := common.Parse(resp, v4Client.ParseGetMachineProfileResponse)
+	if err != nil {
+		return FirstBloodsResponse{ResponseMeta: meta}, err
+	}
+
+	info := parsed.JSON200.Info
+	return FirstBloodsResponse{
+		Data: FirstBloods{
+			User: firstBlood(info.UserBlood),
+			Root: firstBlood(info.RootBlood),
+		},
+		ResponseMeta: meta,
+	}, nil
+}
+
+func firstBlood(b v4Client.BloodInfo) FirstBlood {
+	if b.User.Id == 0 {
+		return FirstBlood{Status: BloodOpen}
+	}
+	return FirstBlood{
+		Status:   BloodClaimed,
+		UserID:   b.User.Id,
+		Username: b.User.Name,
+		TakenAt:  parseBloodTime(b.CreatedAt),
+		Elapsed:  b.BloodDifference,
+	}
+}
+
+// parseBloodTime accepts the timestamp layouts used by the machine endpoints.
+// Unparseable values yield the zero time.
+func parseBloodTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,51 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrHistoricalUnavailable is returned by Handle.Machines for a season other
+// than the active one. The API only serves the machine list of the current
+// season, so past lineups cannot be retrieved. It matches
+// ErrSeasonNotCurrent with errors.Is.
+type ErrHistoricalUnavailable struct {
+	SeasonID int
+}
+
+func (e *ErrHistoricalUnavailable) Error() string {
+	return fmt.Sprintf("machine list for season %d is unavailable: the API only serves the current season", e.SeasonID)
+}
+
+func (e *ErrHistoricalUnavailable) Is(target error) bool {
+	return target == ErrSeasonNotCurrent
+}
+
+// Machines retrieves the machines of this season. The API has no season
+// parameter for the machine list, so this only works for the active
+// season; for any other season it returns *ErrHistoricalUnavailable rather
+// than the current lineup. The season list is fetched first to check.
+//
+// Example:
+//
+//	machines, err := client.Seasons.Season(12).Machines(ctx)
+//	var unavailable *seasons.ErrHistoricalUnavailable
+//	if errors.As(err, &unavailable) {
+//		log.Printf("season %d is over; its lineup is no longer served", unavailable.SeasonID)
+//	} else if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, machine := range machines.Data {
+//		fmt.Printf("Machine: %s\n", machine.Name)
+//	}
+func (h *Handle) Machines(ctx context.Context) (MachinesResponse, error) {
+	service := NewService(h.client)
+	list, err := service.List(ctx)
+	if err != nil {
+		return MachinesResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	if !isActiveSeason(list.Data, h.id) {
+		return MachinesResponse{ResponseMeta: list.ResponseMeta}, &ErrHistoricalUnavailable{SeasonID: h.id}
+	}
+	return service.Machines(ctx)
+}
@@ -0,0 +1,108 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+type LeaderboardRangeResponse struct {
+	// Data holds the players at the requested positions, in order.
+	Data []LeaderboardEntry
+	// Total is the number of players on the leaderboard.
+	Total int
+	// Warning is set when the range reaches past the last player; Data then
+	// holds only the positions that exist.
+	Warning      string
+	ResponseMeta common.ResponseMeta
+}
+
+// LeaderboardRange retrieves positions from through to, inclusive, of the
+// season player leaderboard. Only the pages covering the range are fetched;
+// the page size is taken from the first response, so a server that ignores
+// per_page still costs at most one extra request. A from below 1 is treated
+// as 1.
+//
+// Positions past the end of the leaderboard are not an error: the
+// available tail is returned, possibly empty, with Warning explaining the
+// shortfall.
+//
+// Example:
+//
+//	window, err := client.Seasons.Season(7).LeaderboardRange(ctx, 90, 110)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if window.Warning != "" {
+//		log.Println(window.Warning)
+//	}
+//	for _, p := range window.Data {
+//		fmt.Printf("#%d %s (%d points)\n", p.Rank, p.Name, p.Points)
+//	}
+func (h *Handle) LeaderboardRange(ctx context.Context, from, to int) (LeaderboardRangeResponse, error) {
+	from = max(from, 1)
+	if to < from {
+		return LeaderboardRangeResponse{}, fmt.Errorf("invalid leaderboard range %d-%d", from, to)
+	}
+
+	entries, total, meta, err := h.leaderboardPositions(ctx, from, to)
+	if err != nil {
+		return LeaderboardRangeResponse{ResponseMeta: meta}, err
+	}
+
+	out := LeaderboardRangeResponse{
+		Data:         entries,
+		Total:        total,
+		ResponseMeta: meta,
+	}
+	if got := len(entries); got < to-from+1 {
+		out.Warning = fmt.Sprintf("requested positions %d-%d but the leaderboard ends at %d; returned %d", from, to, from+got-1, got)
+		if got == 0 {
+			out.Warning = fmt.Sprintf("requested positions %d-%d but the leaderboard ends before %d", from, to, from)
+		}
+	}
+	return out, nil
+}
+
+// leaderboardPositions fetches the players at positions lo through hi of
+// the player leaderboard, along with its total size. Positions are counted
+// across pages, so they match ranks except where players tie.
+func (h *Handle) leaderboardPositions(ctx context.Context, lo, hi int) ([]LeaderboardEntry, int, common.ResponseMeta, error) {
+	perPage := leaderboardPageSize
+	out := []LeaderboardEntry{}
+	var meta common.ResponseMeta
+	total := 0
+
+	for page := (lo-1)/perPage + 1; ; page++ {
+		resp, err := h.leaderboardPage(ctx, LeaderboardPlayers, page, perPage)
+		if err != nil {
+			return nil, 0, resp.ResponseMeta, err
+		}
+		meta = resp.ResponseMeta
+		total = resp.Data.Meta.Total
+
+		// The server may ignore per_page; restart from the right page
+		// using the size it actually applied.
+		if size := resp.Data.Meta.PerPage; size > 0 && size != perPage {
+			perPage = size
+			out = out[:0]
+			page = (lo - 1) / perPage
+			continue
+		}
+
+		first := (page-1)*perPage + 1
+		for i, entry := range resp.Data.Data {
+			if pos := first + i; pos >= lo && pos <= hi {
+				out = append(out, entry)
+			}
+		}
+
+		last := resp.Data.Meta.LastPage
+		if page*perPage >= hi || len(resp.Data.Data) < perPage || (last > 0 && page >= last) {
+			break
+		}
+	}
+
+	return out, total, meta, nil
+}
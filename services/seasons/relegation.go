@@ -0,0 +1,135 @@
+package seasons
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// RelegationRisk describes how close the user is to dropping out of their
+// current tier.
+type RelegationRisk struct {
+	Tier   string
+	Points int
+	// Cutoff is the fewest points held by a player still in Tier.
+	Cutoff int
+	// Margin is Points minus Cutoff.
+	Margin int
+	// MarginPercent is Margin as a percentage of Points.
+	MarginPercent float64
+	// AtRisk is set when MarginPercent is below the requested threshold.
+	AtRisk bool
+	// Estimated is set when the tier boundary was not reached within the
+	// leaderboard scan, so Cutoff is only an upper bound; Warning explains
+	// why. When the boundary was found, Cutoff is exact and Warning empty.
+	Estimated bool
+	Warning   string
+}
+
+type RelegationRiskResponse struct {
+	Data         RelegationRisk
+	ResponseMeta common.ResponseMeta
+}
+
+const (
+	defaultRelegationThreshold = 10
+	relegationMaxPages         = 5
+)
+
+// RelegationRisk reports the authenticated user's margin above the points
+// cutoff of their current tier. AtRisk is set when the margin is below
+// thresholdPercent of the user's points; zero or less defaults to 10%.
+//
+// The API does not report tier cutoffs, so the cutoff is read from the
+// leaderboard: up to five pages below the user are scanned for the last
+// player in the same tier. Once the scan reaches a player in a lower tier,
+// or the end of the leaderboard, the last player seen in the user's tier
+// holds the exact cutoff. If the boundary is not reached within the scan,
+// the lowest player seen is used, and Estimated and Warning say so.
+//
+// Example:
+//
+//	risk, err := client.Seasons.Season(7).RelegationRisk(ctx, 15)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if risk.Data.AtRisk {
+//		fmt.Printf("Only %d points above the %s cutoff\n", risk.Data.Margin, risk.Data.Tier)
+//	}
+func (h *Handle) RelegationRisk(ctx context.Context, thresholdPercent float64) (RelegationRiskResponse, error) {
+	if thresholdPercent <= 0 {
+		thresholdPercent = defaultRelegationThreshold
+	}
+
+	rank, err := h.UserRank(ctx)
+	if err != nil {
+		return RelegationRiskResponse{ResponseMeta: rank.ResponseMeta}, err
+	}
+	me := rank.Data
+	if me.Rank <= 0 || me.League == "" {
+		return RelegationRiskResponse{ResponseMeta: rank.ResponseMeta}, errors.New("user has no tier in this season")
+	}
+
+	risk := RelegationRisk{
+		Tier:   me.League,
+		Points: me.TotalSeasonPoints,
+		Cutoff: me.TotalSeasonPoints,
+	}
+
+	perPage := leaderboardPageSize
+	page := (me.Rank-1)/perPage + 1
+	boundary := false
+	meta := rank.ResponseMeta
+
+	for scanned := 0; scanned < relegationMaxPages && !boundary; scanned++ {
+		resp, err := h.leaderboardPage(ctx, LeaderboardPlayers, page, perPage)
+		if err != nil {
+			return RelegationRiskResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+		meta = resp.ResponseMeta
+
+		// Start over from the right page if the server ignored per_page.
+		if size := resp.Data.Meta.PerPage; size > 0 && size != perPage {
+			perPage = size
+			page = (me.Rank-1)/perPage + 1
+			scanned--
+			continue
+		}
+
+		for _, entry := range resp.Data.Data {
+			if entry.Rank <= me.Rank {
+				continue
+			}
+			if !strings.EqualFold(entry.LeagueRank, me.League) {
+				boundary = true
+				break
+			}
+			risk.Cutoff = entry.Points
+		}
+
+		last := resp.Data.Meta.LastPage
+		if len(resp.Data.Data) == 0 || (last > 0 && page >= last) {
+			boundary = true
+			break
+		}
+		page++
+	}
+
+	if !boundary {
+		risk.Estimated = true
+		risk.Warning = "tier boundary not reached within the leaderboard scan; cutoff is an upper bound"
+	}
+
+	risk.Margin = risk.Points - risk.Cutoff
+	if risk.Points > 0 {
+		risk.MarginPercent = float64(risk.Margin) / float64(risk.Points) * 100
+	}
+	risk.AtRisk = risk.MarginPercent < thresholdPercent
+
+	return RelegationRiskResponse{
+		Data:         risk,
+		ResponseMeta: meta,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package seasons_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLeaderboard serves a season leaderboard of players ranked 1 to total,
+// where rank r has 10000-10r points and is in tierOf(r). If pageSize is
+// set, per_page is ignored in favour of it, as the live API sometimes does.
+type fakeLeaderboard struct {
+	total    int
+	tierOf   func(rank int) string
+	pageSize int
+}
+
+func (f fakeLeaderboard) points(rank int) int { return 10000 - 10*rank }
+
+func (f fakeLeaderboard) serve(srv *gohtbtest.Server, myRank int) {
+	srv.HandleFunc("GetSeasonUserRank", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"data": map[string]any{
+			"rank":                myRank,
+			"league":              f.tierOf(myRank),
+			"total_season_points": f.points(myRank),
+		}})
+	})
+	srv.HandleFunc("GetSeasonLeaderboard", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if f.pageSize > 0 {
+			perPage = f.pageSize
+		}
+		lastPage := (f.total + perPage - 1) / perPage
+		var rows []map[string]any
+		for rank := (page-1)*perPage + 1; rank <= min(page*perPage, f.total); rank++ {
+			rows = append(rows, map[string]any{
+				"rank":        rank,
+				"name":        "player" + strconv.Itoa(rank),
+				"points":      f.points(rank),
+				"league_rank": f.tierOf(rank),
+			})
+		}
+		writeJSON(w, map[string]any{
+			"data": rows,
+			"meta": map[string]any{"current_page": page, "last_page": lastPage, "per_page": perPage, "total": f.total},
+		})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// tiers returns a tierOf that puts ranks up to goldUntil in Gold and the
+// rest in Silver.
+func tiers(goldUntil int) func(int) string {
+	return func(rank int) string {
+		if rank <= goldUntil {
+			return "Gold"
+		}
+		return "Silver"
+	}
+}
+
+func TestRelegationRisk(t *testing.T) {
+	tests := []struct {
+		name          string
+		board         fakeLeaderboard
+		myRank        int
+		threshold     float64
+		wantCutoff    int
+		wantEstimated bool
+		wantAtRisk    bool
+	}{
+		{
+			name:       "boundary on the user's page",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(50)},
+			myRank:     10,
+			threshold:  1,
+			wantCutoff: 10000 - 10*50,
+		},
+		{
+			name:       "boundary on the next page",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(100)},
+			myRank:     10,
+			threshold:  1,
+			wantCutoff: 10000 - 10*100,
+		},
+		{
+			name:       "boundary just past the last player of a page",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(101)},
+			myRank:     10,
+			threshold:  1,
+			wantCutoff: 10000 - 10*101,
+		},
+		{
+			name:       "user is the last player in the tier",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(50)},
+			myRank:     50,
+			wantCutoff: 10000 - 10*50,
+			wantAtRisk: true,
+		},
+		{
+			name:       "tier runs to the end of the leaderboard",
+			board:      fakeLeaderboard{total: 150, tierOf: tiers(1000)},
+			myRank:     10,
+			wantCutoff: 10000 - 10*150,
+		},
+		{
+			name:       "server ignores per_page",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(120), pageSize: 50},
+			myRank:     60,
+			threshold:  1,
+			wantCutoff: 10000 - 10*120,
+		},
+		{
+			name:          "boundary beyond the scan",
+			board:         fakeLeaderboard{total: 900, tierOf: tiers(900)},
+			myRank:        1,
+			wantCutoff:    10000 - 10*500,
+			wantEstimated: true,
+		},
+		{
+			name:       "default threshold of 10%",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(50)},
+			myRank:     10,
+			wantCutoff: 10000 - 10*50,
+			wantAtRisk: true,
+		},
+		{
+			name:       "margin under the threshold",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(12)},
+			myRank:     10,
+			threshold:  1,
+			wantCutoff: 10000 - 10*12,
+			wantAtRisk: true,
+		},
+		{
+			name:       "margin at the threshold",
+			board:      fakeLeaderboard{total: 300, tierOf: tiers(50)},
+			myRank:     10,
+			threshold:  float64(400) / float64(9900) * 100,
+			wantCutoff: 10000 - 10*50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gohtbtest.NewServer()
+			defer srv.Close()
+			tt.board.serve(srv, tt.myRank)
+			client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+			require.NoError(t, err)
+
+			risk, err := client.Seasons.Season(7).RelegationRisk(context.Background(), tt.threshold)
+			require.NoError(t, err)
+
+			got := risk.Data
+			points := 10000 - 10*tt.myRank
+			assert.Equal(t, "Gold", got.Tier)
+			assert.Equal(t, points, got.Points)
+			assert.Equal(t, tt.wantCutoff, got.Cutoff)
+			assert.Equal(t, points-tt.wantCutoff, got.Margin)
+			assert.InDelta(t, float64(points-tt.wantCutoff)/float64(points)*100, got.MarginPercent, 1e-9)
+			assert.Equal(t, tt.wantAtRisk, got.AtRisk)
+			assert.Equal(t, tt.wantEstimated, got.Estimated)
+			if tt.wantEstimated {
+				assert.NotEmpty(t, got.Warning)
+			} else {
+				assert.Empty(t, got.Warning)
+			}
+		})
+	}
+}
+
+func TestRelegationRiskWithoutTier(t *testing.T) {
+	srv := gohtbtest.NewServer().
+		JSON("GetSeasonUserRank", `{"data":{"rank":0,"league":""}}`)
+	defer srv.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+
+	_, err = client.Seasons.Season(7).RelegationRisk(context.Background(), 0)
+	assert.Error(t, err)
+	assert.Empty(t, srv.Requests("GetSeasonLeaderboard"))
+}
@@ -195,6 +195,8 @@ type MachinesResponse struct {
 // Machines retrieves all machines available in the current season.
 // This returns information about machines that are part of the active season,
 // including their difficulty, points, and availability status.
+// It is equivalent to Handle.Machines on the active season, without the
+// season check.
 //
 // Example:
 //
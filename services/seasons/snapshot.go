@@ -0,0 +1,181 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+// OwnedMachine records which flags the user holds on a seasonal machine.
+type OwnedMachine struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	User bool   `json:"user"`
+	Root bool   `json:"root"`
+}
+
+// Snapshot captures the authenticated user's standing in a season at a point
+// in time. Snapshots are plain values and can be persisted with encoding/json.
+type Snapshot struct {
+	SeasonID      int            `json:"season_id"`
+	TakenAt       time.Time      `json:"taken_at"`
+	Rank          int            `json:"rank"`
+	Points        int            `json:"points"`
+	Tier          string         `json:"tier"`
+	UserOwns      int            `json:"user_owns"`
+	RootOwns      int            `json:"root_owns"`
+	OwnedMachines []OwnedMachine `json:"owned_machines"`
+}
+
+// Delta is the change between two snapshots of the same season.
+type Delta struct {
+	Elapsed time.Duration
+	// RankChange is positive when the user climbed the leaderboard.
+	RankChange   int
+	PointsGained int
+	TierFrom     string
+	TierTo       string
+	// NewUserOwns and NewRootOwns list machine IDs owned since the earlier snapshot.
+	NewUserOwns []int
+	NewRootOwns []int
+}
+
+// TierChanged reports whether the user moved to a different tier.
+func (d Delta) TierChanged() bool {
+	return d.TierFrom != d.TierTo
+}
+
+// ErrDifferentSeasons is returned by Snapshot.DeltaTo when the snapshots
+// belong to different seasons, for example after a season rollover.
+type ErrDifferentSeasons struct {
+	From int
+	To   int
+}
+
+func (e *ErrDifferentSeasons) Error() string {
+	return fmt.Sprintf("snapshots belong to different seasons: %d and %d", e.From, e.To)
+}
+
+// Snapshot captures the user's rank, points, tier and owned seasonal machines
+// for this season.
+//
+// Owned machines come from the seasonal machine list, which the API serves for
+// the active season only; for past seasons OwnedMachines is empty.
+//
+// Example:
+//
+//	start, err := client.Seasons.Season(7).Snapshot(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// ... later
+//	now, err := client.Seasons.Season(7).Snapshot(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	delta, err := start.DeltaTo(now)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Points this session: +%d\n", delta.PointsGained)
+func (h *Handle) Snapshot(ctx context.Context) (Snapshot, error) {
+	rank, err := h.UserRank(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snap := Snapshot{
+		SeasonID:      h.id,
+		TakenAt:       clock.From(h.client).Now().UTC(),
+		Rank:          rank.Data.Rank,
+		Points:        rank.Data.TotalSeasonPoints,
+		Tier:          rank.Data.League,
+		UserOwns:      rank.Data.UserOwns,
+		RootOwns:      rank.Data.RootOwns,
+		OwnedMachines: []OwnedMachine{},
+	}
+
+	service := NewService(h.client)
+	list, err := service.List(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if !isActiveSeason(list.Data, h.id) {
+		return snap, nil
+	}
+
+	machines, err := service.Machines(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for _, m := range machines.Data {
+		if !m.IsOwnedUser && !m.IsOwnedRoot {
+			continue
+		}
+		snap.OwnedMachines = append(snap.OwnedMachines, OwnedMachine{
+			ID:   m.Id,
+			Name: m.Name,
+			User: m.IsOwnedUser,
+			Root: m.IsOwnedRoot,
+		})
+	}
+
+	return snap, nil
+}
+
+// DeltaTo computes the change from s to other, where other is the later
+// snapshot. It returns *ErrDifferentSeasons if the snapshots were taken in
+// different seasons.
+//
+// Example:
+//
+//	delta, err := start.DeltaTo(now)
+//	var rollover *seasons.ErrDifferentSeasons
+//	if errors.As(err, &rollover) {
+//		fmt.Println("New season started, resetting overlay")
+//	}
+func (s Snapshot) DeltaTo(other Snapshot) (Delta, error) {
+	if s.SeasonID != other.SeasonID {
+		return Delta{}, &ErrDifferentSeasons{From: s.SeasonID, To: other.SeasonID}
+	}
+
+	d := Delta{
+		Elapsed:      other.TakenAt.Sub(s.TakenAt),
+		PointsGained: other.Points - s.Points,
+		TierFrom:     s.Tier,
+		TierTo:       other.Tier,
+	}
+	if s.Rank > 0 && other.Rank > 0 {
+		d.RankChange = s.Rank - other.Rank
+	}
+
+	before := make(map[int]OwnedMachine, len(s.OwnedMachines))
+	for _, m := range s.OwnedMachines {
+		before[m.ID] = m
+	}
+	for _, m := range other.OwnedMachines {
+		prev := before[m.ID]
+		if m.User && !prev.User {
+			d.NewUserOwns = append(d.NewUserOwns, m.ID)
+		}
+		if m.Root && !prev.Root {
+			d.NewRootOwns = append(d.NewRootOwns, m.ID)
+		}
+	}
+	sort.Ints(d.NewUserOwns)
+	sort.Ints(d.NewRootOwns)
+
+	return d, nil
+}
+
+func isActiveSeason(list []SeasonListDataItem, id int) bool {
+	for _, season := range list {
+		if season.Id == id {
+			return season.Active
+		}
+	}
+	return false
+}
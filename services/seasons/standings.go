@@ -0,0 +1,238 @@
+package seasons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/teams"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// ErrSeasonNotCurrent is returned by Standings for seasons other than the
+// active one; the API only lists the machines of the current season.
+var ErrSeasonNotCurrent = errors.New("season is not the current season")
+
+// MachineSolveRecord is one member's progress on one season machine.
+// UserOwnedAt or RootOwnedAt is nil when that flag has not been taken.
+type MachineSolveRecord struct {
+	MachineID   int
+	MachineName string
+	UserOwnedAt *time.Time
+	RootOwnedAt *time.Time
+	UserBlood   bool
+	RootBlood   bool
+}
+
+// MemberProgress lists the season machines a member has owned, ordered by
+// their first own. Unknown is set when the member's activity could not be
+// read, typically because their profile is private.
+type MemberProgress struct {
+	UserID        int
+	Username      string
+	MachineSolves []MachineSolveRecord
+	Unknown       bool
+}
+
+type TeamStandings struct {
+	SeasonID int
+	TeamID   int
+	TeamName string
+	Members  []MemberProgress
+	Warnings []string
+}
+
+type TeamStandingsResponse struct {
+	Data         TeamStandings
+	ResponseMeta common.ResponseMeta
+}
+
+// Standings reports, for each member of a team, which machines of the
+// season they have owned and when. A teamID of 0 uses the authenticated
+// user's team and fails with ErrNotInTeam if they have none.
+//
+// Only the current season is supported, since the season machine list is
+// not available for past seasons; other IDs fail with ErrSeasonNotCurrent.
+// Each member's activity feed is fetched with bounded parallelism. Members
+// whose activity cannot be read are marked Unknown with a warning instead of
+// failing the call.
+//
+// Example:
+//
+//	standings, err := client.Seasons.Standings(ctx, 7, 0)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range standings.Data.Members {
+//		fmt.Printf("%s: %d machines\n", m.Username, len(m.MachineSolves))
+//	}
+func (s *Service) Standings(ctx context.Context, seasonID, teamID int) (TeamStandingsResponse, error) {
+	if teamID == 0 {
+		info, err := users.NewService(s.base.Client).Info(ctx)
+		if err != nil {
+			return TeamStandingsResponse{ResponseMeta: info.ResponseMeta}, err
+		}
+		teamID = info.Data.Info.Team.Id
+		if teamID == 0 {
+			return TeamStandingsResponse{ResponseMeta: info.ResponseMeta}, ErrNotInTeam
+		}
+	}
+
+	list, err := s.List(ctx)
+	if err != nil {
+		return TeamStandingsResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	var season *SeasonListDataItem
+	for i := range list.Data {
+		if list.Data[i].Id == seasonID {
+			season = &list.Data[i]
+			break
+		}
+	}
+	if season == nil {
+		return TeamStandingsResponse{ResponseMeta: list.ResponseMeta}, fmt.Errorf("season %d not found", seasonID)
+	}
+	if !season.Active {
+		return TeamStandingsResponse{ResponseMeta: list.ResponseMeta}, fmt.Errorf("season %d: %w", seasonID, ErrSeasonNotCurrent)
+	}
+
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return TeamStandingsResponse{ResponseMeta: machines.ResponseMeta}, err
+	}
+	seasonMachines := make(map[int]string, len(machines.Data))
+	for _, m := range machines.Data {
+		seasonMachines[m.Id] = m.Name
+	}
+
+	team := teams.NewService(s.base.Client).Team(teamID)
+	info, err := team.Info(ctx)
+	if err != nil {
+		return TeamStandingsResponse{ResponseMeta: info.ResponseMeta}, err
+	}
+	members, err := team.Members(ctx)
+	if err != nil {
+		return TeamStandingsResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+
+	warnings := make([]string, len(members.Data))
+	userService := users.NewService(s.base.Client)
+
+	results, err := batch.Collect(ctx, len(members.Data), batch.DefaultConcurrency, func(ctx context.Context, i int) (MemberProgress, error) {
+		m := members.Data[i]
+		progress := MemberProgress{UserID: m.Id, Username: m.Name, MachineSolves: []MachineSolveRecord{}}
+
+		if m.Public == 0 {
+			progress.Unknown = true
+			warnings[i], _ = common.PrivateMemberWarning(m.Name, m.Id, nil)
+			return progress, nil
+		}
+
+		activity, err := userService.User(m.Id).ProfileActivity().AllResults(ctx)
+		if err != nil {
+			warning, private := common.PrivateMemberWarning(m.Name, m.Id, err)
+			if !private {
+				return progress, err
+			}
+			progress.Unknown = true
+			warnings[i] = warning
+			return progress, nil
+		}
+
+		progress.MachineSolves = seasonSolves(activity.Data, seasonMachines, season.StartDate, season.EndDate)
+		return progress, nil
+	})
+	if err != nil {
+		return TeamStandingsResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+	if err := results.Err(); err != nil {
+		return TeamStandingsResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+	progress := make([]MemberProgress, len(members.Data))
+	for i, p := range results.Succeeded {
+		progress[i] = p
+	}
+
+	out := TeamStandings{
+		SeasonID: seasonID,
+		TeamID:   teamID,
+		TeamName: info.Data.Name,
+		Members:  progress,
+	}
+	for _, w := range warnings {
+		if w != "" {
+			out.Warnings = append(out.Warnings, w)
+		}
+	}
+
+	return TeamStandingsResponse{
+		Data:         out,
+		ResponseMeta: members.ResponseMeta,
+	}, nil
+}
+
+// seasonSolves collects the owns of season machines that happened within
+// the season's dates. A zero start or end leaves that side open.
+func seasonSolves(activity users.UserProfileActivityItems, machines map[int]string, start, end time.Time) []MachineSolveRecord {
+	byMachine := map[int]*MachineSolveRecord{}
+	for _, item := range activity {
+		own, ok := item.AsMachineOwn()
+		if !ok {
+			continue
+		}
+		name, tracked := machines[own.Id]
+		if !tracked || (own.Type != "user" && own.Type != "root") {
+			continue
+		}
+		at := own.OwnDate.UTC()
+		if (!start.IsZero() && at.Before(start)) || (!end.IsZero() && at.After(end)) {
+			continue
+		}
+
+		rec, ok := byMachine[own.Id]
+		if !ok {
+			rec = &MachineSolveRecord{MachineID: own.Id, MachineName: name}
+			byMachine[own.Id] = rec
+		}
+		switch own.Type {
+		case "user":
+			if rec.UserOwnedAt == nil || at.Before(*rec.UserOwnedAt) {
+				rec.UserOwnedAt = &at
+			}
+			rec.UserBlood = rec.UserBlood || own.Blood
+		case "root":
+			if rec.RootOwnedAt == nil || at.Before(*rec.RootOwnedAt) {
+				rec.RootOwnedAt = &at
+			}
+			rec.RootBlood = rec.RootBlood || own.Blood
+		}
+	}
+
+	solves := make([]MachineSolveRecord, 0, len(byMachine))
+	for _, rec := range byMachine {
+		solves = append(solves, *rec)
+	}
+	sort.Slice(solves, func(i, j int) bool {
+		a, b := firstOwn(solves[i]), firstOwn(solves[j])
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return solves[i].MachineID < solves[j].MachineID
+	})
+	return solves
+}
+
+func firstOwn(r MachineSolveRecord) time.Time {
+	switch {
+	case r.UserOwnedAt == nil:
+		return *r.RootOwnedAt
+	case r.RootOwnedAt == nil || r.UserOwnedAt.Before(*r.RootOwnedAt):
+		return *r.UserOwnedAt
+	default:
+		return *r.RootOwnedAt
+	}
+}
@@ -0,0 +1,170 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gubarz/gohtb/humanize"
+)
+
+const summaryTopSize = 10
+
+// SeasonSummary is a digest of one season.
+type SeasonSummary struct {
+	SeasonID int
+	Name     string
+	// TopUsers and TopTeams hold the first ten players and teams on the
+	// season leaderboard. Team members are not fetched.
+	TopUsers []LeaderboardEntry
+	TopTeams []TeamRankEntry
+	// MachinesReleased counts the released seasonal machines while the
+	// season is active. The API does not list the machines of past seasons,
+	// so for those it is nil.
+	MachinesReleased  *int
+	TotalParticipants int
+	// AveragePoints is the season points total divided by the number of
+	// participants. It is only computed with WithAveragePoints, and is zero
+	// otherwise.
+	AveragePoints float64
+	StartDate     time.Time
+	// EndDate is the scheduled end while the season is active.
+	EndDate time.Time
+	// WinnerUserID is the top player once the season has ended, and zero
+	// while it is active.
+	WinnerUserID int
+}
+
+// EndsIn describes the season's end relative to now, such as "in 2 weeks",
+// or "3 months ago" for a past season.
+func (s SeasonSummary) EndsIn(now time.Time) string {
+	return humanize.Relative(s.EndDate, now)
+}
+
+type summarizeOptions struct {
+	averagePoints bool
+}
+
+// SummarizeOption configures Summarize.
+type SummarizeOption func(*summarizeOptions)
+
+// WithAveragePoints makes Summarize fill in AveragePoints. The average is
+// computed by walking the player leaderboard until the remaining players
+// have no points, at one request per 100 scoring players, so a busy season
+// can take hundreds of requests.
+func WithAveragePoints() SummarizeOption {
+	return func(o *summarizeOptions) {
+		o.averagePoints = true
+	}
+}
+
+// Summarize builds a digest of the season: its dates, top players and
+// teams, participant count and, while the season is active, its machine
+// count. Without options it takes at most four requests; WithAveragePoints
+// adds the average points at the cost of walking the leaderboard.
+//
+// Example:
+//
+//	summary, err := client.Seasons.Summarize(ctx, 7, seasons.WithAveragePoints())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %d players, %.1f points on average\n", summary.Name, summary.TotalParticipants, summary.AveragePoints)
+//	if summary.MachinesReleased != nil {
+//		fmt.Printf("%d machines released\n", *summary.MachinesReleased)
+//	}
+//	for _, u := range summary.TopUsers {
+//		fmt.Printf("#%d %s (%d points)\n", u.Rank, u.Name, u.Points)
+//	}
+func (s *Service) Summarize(ctx context.Context, seasonID int, opts ...SummarizeOption) (SeasonSummary, error) {
+	var o summarizeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	list, err := s.List(ctx)
+	if err != nil {
+		return SeasonSummary{}, err
+	}
+	var season *SeasonListDataItem
+	for i := range list.Data {
+		if list.Data[i].Id == seasonID {
+			season = &list.Data[i]
+			break
+		}
+	}
+	if season == nil {
+		return SeasonSummary{}, fmt.Errorf("season %d not found", seasonID)
+	}
+
+	summary := SeasonSummary{
+		SeasonID:          seasonID,
+		Name:              season.Name,
+		TopUsers:          []LeaderboardEntry{},
+		TopTeams:          []TeamRankEntry{},
+		TotalParticipants: season.Players,
+		StartDate:         season.StartDate,
+		EndDate:           season.EndDate,
+	}
+
+	if season.Active {
+		machines, err := s.Machines(ctx)
+		if err != nil {
+			return SeasonSummary{}, err
+		}
+		released := 0
+		for _, m := range machines.Data {
+			if m.IsReleased {
+				released++
+			}
+		}
+		summary.MachinesReleased = &released
+	}
+
+	h := s.Season(seasonID)
+	perPage := summaryTopSize
+	if o.averagePoints {
+		perPage = leaderboardPageSize
+	}
+	total := 0
+	for page := 1; ; page++ {
+		resp, err := h.leaderboardPage(ctx, LeaderboardPlayers, page, perPage)
+		if err != nil {
+			return SeasonSummary{}, err
+		}
+		entries := resp.Data.Data
+		if page == 1 {
+			summary.TopUsers = append(summary.TopUsers, entries[:min(summaryTopSize, len(entries))]...)
+			if summary.TotalParticipants == 0 {
+				summary.TotalParticipants = resp.Data.Meta.Total
+			}
+		}
+		if !o.averagePoints {
+			break
+		}
+		for _, e := range entries {
+			total += e.Points
+		}
+		last := resp.Data.Meta.LastPage
+		if len(entries) == 0 || entries[len(entries)-1].Points == 0 || (last > 0 && page >= last) {
+			break
+		}
+	}
+	if o.averagePoints && summary.TotalParticipants > 0 {
+		summary.AveragePoints = float64(total) / float64(summary.TotalParticipants)
+	}
+
+	teams, err := h.leaderboardPage(ctx, LeaderboardTeams, 1, summaryTopSize)
+	if err != nil {
+		return SeasonSummary{}, err
+	}
+	for _, item := range teams.Data.Data[:min(summaryTopSize, len(teams.Data.Data))] {
+		summary.TopTeams = append(summary.TopTeams, teamRankEntry(item))
+	}
+
+	if !season.Active && len(summary.TopUsers) > 0 {
+		summary.WinnerUserID = summary.TopUsers[0].ResourceId
+	}
+
+	return summary, nil
+}
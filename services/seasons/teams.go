@@ -0,0 +1,181 @@
+package seasons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/teams"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// ErrNotInTeam is returned by MyTeamRank when the authenticated user has no team.
+var ErrNotInTeam = errors.New("user is not in a team")
+
+// TeamRankEntry is one team's standing in a season.
+type TeamRankEntry struct {
+	TeamID         int
+	TeamName       string
+	Rank           int
+	Points         int
+	MachinesSolved int
+	UserOwns       int
+	RootOwns       int
+	UserBloods     int
+	RootBloods     int
+	// Members is the team roster with each member's overall stats. It is
+	// only filled by TeamRankings when WithMembers is given.
+	Members []teams.TeamMember
+}
+
+type TeamRankingsResponse struct {
+	Data         []TeamRankEntry
+	Meta         common.Meta
+	ResponseMeta common.ResponseMeta
+}
+
+type teamRankingsOptions struct {
+	members bool
+}
+
+// TeamRankingsOption configures TeamRankings.
+type TeamRankingsOption func(*teamRankingsOptions)
+
+// WithMembers makes TeamRankings fill in each team's Members. This costs
+// one extra request per team on the page, on top of the leaderboard
+// request.
+func WithMembers() TeamRankingsOption {
+	return func(o *teamRankingsOptions) {
+		o.members = true
+	}
+}
+
+// TeamRankings retrieves one page of the season's team leaderboard with a
+// single request. Members is left empty unless WithMembers is given, in
+// which case each team's roster is fetched with bounded parallelism, at one
+// request per team.
+//
+// Example:
+//
+//	rankings, err := client.Seasons.Season(7).TeamRankings(ctx, 1, 25, seasons.WithMembers())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, t := range rankings.Data {
+//		fmt.Printf("#%d %s: %d points, %d members\n", t.Rank, t.TeamName, t.Points, len(t.Members))
+//	}
+func (h *Handle) TeamRankings(ctx context.Context, page, perPage int, opts ...TeamRankingsOption) (TeamRankingsResponse, error) {
+	var o teamRankingsOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = leaderboardPageSize
+	}
+
+	resp, err := h.leaderboardPage(ctx, LeaderboardTeams, page, perPage)
+	if err != nil {
+		return TeamRankingsResponse{ResponseMeta: resp.ResponseMeta}, err
+	}
+
+	entries := make([]TeamRankEntry, len(resp.Data.Data))
+	for i, item := range resp.Data.Data {
+		entries[i] = teamRankEntry(item)
+	}
+	if o.members {
+		if err := h.fillTeamMembers(ctx, entries); err != nil {
+			return TeamRankingsResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+	}
+
+	return TeamRankingsResponse{
+		Data:         entries,
+		Meta:         resp.Data.Meta,
+		ResponseMeta: resp.ResponseMeta,
+	}, nil
+}
+
+// MyTeamRank retrieves the season standing of the authenticated user's team,
+// including its Members. It returns ErrNotInTeam if the user has no team.
+//
+// Example:
+//
+//	mine, err := client.Seasons.Season(7).MyTeamRank(ctx)
+//	if errors.Is(err, seasons.ErrNotInTeam) {
+//		fmt.Println("Join a team to compete in team rankings")
+//		return
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s is ranked #%d\n", mine.TeamName, mine.Rank)
+func (h *Handle) MyTeamRank(ctx context.Context) (TeamRankEntry, error) {
+	info, err := users.NewService(h.client).Info(ctx)
+	if err != nil {
+		return TeamRankEntry{}, err
+	}
+	teamID := info.Data.Info.Team.Id
+	if teamID == 0 {
+		return TeamRankEntry{}, ErrNotInTeam
+	}
+
+	for page := 1; ; page++ {
+		resp, err := h.leaderboardPage(ctx, LeaderboardTeams, page, leaderboardPageSize)
+		if err != nil {
+			return TeamRankEntry{}, err
+		}
+		for _, item := range resp.Data.Data {
+			if item.ResourceId != teamID {
+				continue
+			}
+			entry := []TeamRankEntry{teamRankEntry(item)}
+			if err := h.fillTeamMembers(ctx, entry); err != nil {
+				return TeamRankEntry{}, err
+			}
+			return entry[0], nil
+		}
+		last := resp.Data.Meta.LastPage
+		if len(resp.Data.Data) == 0 || (last > 0 && page >= last) {
+			break
+		}
+	}
+
+	return TeamRankEntry{}, fmt.Errorf("team %d is not ranked in season %d", teamID, h.id)
+}
+
+func teamRankEntry(item LeaderboardEntry) TeamRankEntry {
+	return TeamRankEntry{
+		TeamID:         item.ResourceId,
+		TeamName:       item.Name,
+		Rank:           item.Rank,
+		Points:         item.Points,
+		MachinesSolved: item.RootOwns,
+		UserOwns:       item.UserOwns,
+		RootOwns:       item.RootOwns,
+		UserBloods:     item.UserBloods,
+		RootBloods:     item.RootBloods,
+	}
+}
+
+func (h *Handle) fillTeamMembers(ctx context.Context, entries []TeamRankEntry) error {
+	teamService := teams.NewService(h.client)
+	members, err := batch.Collect(ctx, len(entries), batch.DefaultConcurrency, func(ctx context.Context, i int) ([]teams.TeamMember, error) {
+		members, err := teamService.Team(entries[i].TeamID).Members(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("team %d members: %w", entries[i].TeamID, err)
+		}
+		return members.Data, nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, m := range members.Succeeded {
+		entries[i].Members = m
+	}
+	return members.Err()
+}
@@ -0,0 +1,61 @@
+package seasons
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+)
+
+// Upcoming retrieves the seasons that are scheduled but have not started,
+// ordered by start date, soonest first.
+//
+// A season is upcoming when its State says so. For states the client does
+// not recognise, including an empty one, it falls back to an inactive
+// season whose start date is in the future.
+//
+// Example:
+//
+//	upcoming, err := client.Seasons.Upcoming(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if len(upcoming.Data) > 0 {
+//		next := upcoming.Data[0]
+//		days := int(time.Until(next.StartDate).Hours() / 24)
+//		fmt.Printf("%s starts in %d days\n", next.Name, days)
+//	}
+func (s *Service) Upcoming(ctx context.Context) (ListResponse, error) {
+	list, err := s.List(ctx)
+	if err != nil {
+		return ListResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+
+	now := clock.From(s.base.Client).Now()
+	upcoming := make([]SeasonListDataItem, 0)
+	for _, season := range list.Data {
+		if isUpcoming(season, now) {
+			upcoming = append(upcoming, season)
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].StartDate.Before(upcoming[j].StartDate)
+	})
+
+	return ListResponse{
+		Data:         upcoming,
+		ResponseMeta: list.ResponseMeta,
+	}, nil
+}
+
+func isUpcoming(season SeasonListDataItem, now time.Time) bool {
+	switch strings.ToLower(season.State) {
+	case "upcoming", "scheduled", "pending", "coming_soon":
+		return true
+	case "active", "live", "ongoing", "ended", "finished", "past", "completed":
+		return false
+	}
+	return !season.Active && !season.StartDate.IsZero() && season.StartDate.After(now)
+}
@@ -0,0 +1,199 @@
+package seasons
+
+import (
+	"context"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/errutil"
+	"github.com/gubarz/gohtb/internal/poll"
+)
+
+const (
+	defaultWatchInterval = 5 * time.Minute
+	minWatchInterval     = 30 * time.Second
+	watchInitialBackoff  = 2 * time.Second
+	watchMaxBackoff      = 2 * time.Minute
+)
+
+// RankChange reports that a user's season rank or points changed. A rank of
+// zero means the user was not ranked.
+type RankChange struct {
+	UserID    int
+	OldRank   int
+	NewRank   int
+	OldPoints int
+	NewPoints int
+	// At is when the new standing was confirmed.
+	At time.Time
+}
+
+// Moved returns how many places the user climbed; it is negative when they
+// dropped.
+func (c RankChange) Moved() int {
+	return c.OldRank - c.NewRank
+}
+
+type watchOptions struct {
+	backoffMin, backoffMax time.Duration
+}
+
+// WatchOption configures a watch helper such as WatchUserRank.
+type WatchOption func(*watchOptions)
+
+// WithBackoffPolling replaces the fixed polling interval with one that
+// starts at lo and doubles after every poll that sees no change, up to hi.
+// Any change resets it to lo, so an idle watch costs few requests while an
+// active one stays responsive. Both bounds are raised to the watch's
+// minimum interval, and hi to lo, if they are smaller.
+func WithBackoffPolling(lo, hi time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.backoffMin, o.backoffMax = lo, hi
+	}
+}
+
+type standing struct {
+	rank, points int
+}
+
+// WatchUserRank polls the user's rank and points in the season every
+// interval and sends a RankChange whenever either changes. An interval of
+// zero or less defaults to five minutes, and shorter ones than 30 seconds
+// are raised to it. The first poll only records the starting standing.
+//
+// To debounce rapid fluctuations a new standing is reported only once two
+// consecutive polls agree on it, so a change is seen up to two intervals
+// after it happens and a rank that moves and moves back in between is not
+// reported. Requests go through the client's rate limiter like any other
+// call. With WithBackoffPolling the interval argument is ignored and the
+// wait grows while the standing holds still.
+//
+// Transient failures (network errors, 429 and 5xx responses) are retried
+// with exponential backoff. Any other error is sent on the error channel
+// and ends the watch. Both channels are closed when the watch ends, either
+// for that reason or because ctx is done. Changes are not dropped: polling
+// pauses until the caller receives each one.
+//
+// Example:
+//
+//	changes, errs := client.Seasons.Season(7).WatchUserRank(ctx, 12345, time.Minute)
+//	for change := range changes {
+//		if change.Moved() > 0 {
+//			fmt.Printf("You moved up to rank %d\n", change.NewRank)
+//		}
+//	}
+//	if err := <-errs; err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) WatchUserRank(ctx context.Context, userID int, interval time.Duration, opts ...WatchOption) (<-chan RankChange, <-chan error) {
+	var o watchOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	next := poll.Fixed(max(interval, minWatchInterval))
+	if o.backoffMin > 0 || o.backoffMax > 0 {
+		next = poll.Backoff(max(o.backoffMin, minWatchInterval), max(o.backoffMax, minWatchInterval))
+	}
+
+	changes := make(chan RankChange)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(changes)
+
+		clk := clock.From(h.client)
+		var backoff time.Duration
+		var current, pending *standing
+		for {
+			st, ok, err := h.userStanding(ctx, userID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !errutil.Transient(err) {
+					errs <- err
+					return
+				}
+				backoff = min(max(backoff*2, watchInitialBackoff), watchMaxBackoff)
+				if clock.Sleep(ctx, clk, backoff) != nil {
+					return
+				}
+				continue
+			}
+			backoff = 0
+
+			changed := true
+			switch {
+			case !ok:
+				// The leaderboard moved between the two lookups; try again
+				// on the next poll.
+			case current == nil:
+				current = &st
+			case st == *current:
+				changed = pending != nil
+				pending = nil
+			case pending == nil || st != *pending:
+				pending = &st
+			default:
+				change := RankChange{
+					UserID:    userID,
+					OldRank:   current.rank,
+					NewRank:   st.rank,
+					OldPoints: current.points,
+					NewPoints: st.points,
+					At:        clk.Now(),
+				}
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+				current, pending = &st, nil
+			}
+
+			if clock.Sleep(ctx, clk, next.Next(changed)) != nil {
+				return
+			}
+		}
+	}()
+
+	return changes, errs
+}
+
+// userStanding looks up the user's rank with End and their points on the
+// leaderboard page holding that rank. ok is false if the user is no longer
+// on that page by the time it is read.
+func (h *Handle) userStanding(ctx context.Context, userID int) (standing, bool, error) {
+	end, err := h.End(ctx, userID)
+	if err != nil {
+		return standing{}, false, err
+	}
+	rank := end.Data.Rank.Current
+	if rank <= 0 {
+		return standing{}, true, nil
+	}
+
+	perPage := leaderboardPageSize
+	resp, err := h.leaderboardPage(ctx, LeaderboardPlayers, (rank-1)/perPage+1, perPage)
+	if err != nil {
+		return standing{}, false, err
+	}
+	// The server may ignore per_page; refetch using the size it applied.
+	if size := resp.Data.Meta.PerPage; size > 0 && size != perPage {
+		perPage = size
+		resp, err = h.leaderboardPage(ctx, LeaderboardPlayers, (rank-1)/perPage+1, perPage)
+		if err != nil {
+			return standing{}, false, err
+		}
+	}
+	for _, entry := range resp.Data.Data {
+		if entry.ResourceId == userID {
+			return standing{rank: entry.Rank, points: entry.Points}, true, nil
+		}
+	}
+	return standing{}, false, nil
+}
@@ -0,0 +1,102 @@
+package seasons
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// SeasonWinner is the top-ranked player of a season with their season
+// results and full profile.
+type SeasonWinner struct {
+	SeasonID int
+	// IsCurrentLeader is set while the season is active: the player leads
+	// but has not won yet.
+	IsCurrentLeader bool
+	UserID          int
+	Username        string
+	Country         string
+	SeasonPoints    int
+	// MachinesSolved counts the seasonal machines the player rooted.
+	MachinesSolved int
+	// FirstBloods is the player's user and root bloods in the season.
+	FirstBloods int
+	// Profile is the player's current platform profile.
+	Profile users.UserProfile
+}
+
+type WinnerProfileResponse struct {
+	Data         SeasonWinner
+	ResponseMeta common.ResponseMeta
+}
+
+// WinnerProfile returns the player ranked first in the season. For a
+// completed season that is the winner; for an active one it is the current
+// leader, with IsCurrentLeader set.
+//
+// The season results come from the season leaderboard and the profile from
+// the player's basic profile, so the call makes three requests.
+//
+// Example:
+//
+//	winner, err := client.Seasons.Season(7).WinnerProfile(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	label := "Winner"
+//	if winner.Data.IsCurrentLeader {
+//		label = "Leader"
+//	}
+//	fmt.Printf("%s: %s (%s) with %d points\n", label, winner.Data.Username, winner.Data.Country, winner.Data.SeasonPoints)
+func (h *Handle) WinnerProfile(ctx context.Context) (WinnerProfileResponse, error) {
+	list, err := NewService(h.client).List(ctx)
+	if err != nil {
+		return WinnerProfileResponse{ResponseMeta: list.ResponseMeta}, err
+	}
+	var season *SeasonListDataItem
+	for i := range list.Data {
+		if list.Data[i].Id == h.id {
+			season = &list.Data[i]
+			break
+		}
+	}
+	if season == nil {
+		return WinnerProfileResponse{ResponseMeta: list.ResponseMeta}, fmt.Errorf("season %d not found", h.id)
+	}
+
+	board, err := h.leaderboardPage(ctx, LeaderboardPlayers, 1, 1)
+	if err != nil {
+		return WinnerProfileResponse{ResponseMeta: board.ResponseMeta}, err
+	}
+	if len(board.Data.Data) == 0 {
+		return WinnerProfileResponse{ResponseMeta: board.ResponseMeta}, fmt.Errorf("season %d has no ranked players", h.id)
+	}
+	top := board.Data.Data[0]
+
+	profile, err := users.NewService(h.client).User(top.ResourceId).ProfileBasic(ctx)
+	if err != nil {
+		return WinnerProfileResponse{ResponseMeta: profile.ResponseMeta}, err
+	}
+
+	country := top.CountryName
+	if country == "" {
+		country = profile.Data.CountryName
+	}
+
+	return WinnerProfileResponse{
+		Data: SeasonWinner{
+			SeasonID:        h.id,
+			IsCurrentLeader: season.Active,
+			UserID:          top.ResourceId,
+			Username:        top.Name,
+			Country:         country,
+			SeasonPoints:    top.Points,
+			MachinesSolved:  top.RootOwns,
+			FirstBloods:     top.UserBloods + top.RootBloods,
+			Profile:         profile.Data,
+		},
+		ResponseMeta: profile.ResponseMeta,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/fieldmask"
 	"github.com/gubarz/gohtb/internal/ptr"
 )
 
@@ -277,6 +278,32 @@ func (q *SherlockQuery) ByKeyword(keyword string) *SherlockQuery {
 	return qc
 }
 
+// Fields keeps only the named fields of each returned item and zeroes the
+// rest. Names are JSON keys or Go field names, matched without regard to
+// case; an unknown name makes Results fail.
+//
+// The API has no sparse fieldset support, so the projection is done
+// client-side: every page is still downloaded and decoded in full, and
+// ResponseMeta.Raw holds the whole body. Only the decoded items are
+// pruned, which lets the dropped values be garbage collected when large
+// lists are retained.
+// Returns a new SherlockQuery that can be further chained.
+//
+// Example:
+//
+//	sherlocks, err := client.Sherlocks.List().Fields("name", "difficulty").AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range sherlocks.Data {
+//		fmt.Println(s.Name)
+//	}
+func (q *SherlockQuery) Fields(names ...string) *SherlockQuery {
+	qc := ptr.Clone(q)
+	qc.fields = append([]string(nil), names...)
+	return qc
+}
+
 func (q *SherlockQuery) fetchResults(ctx context.Context) (SherlockListResponse, error) {
 	params := &v4Client.GetSherlocksParams{
 		Page:    &q.page,
@@ -321,10 +348,14 @@ func (q *SherlockQuery) fetchResults(ctx context.Context) (SherlockListResponse,
 		return SherlockListResponse{ResponseMeta: meta}, err
 	}
 
-	return SherlockListResponse{
+	out := SherlockListResponse{
 		Data:         parsed.JSON200.Data,
 		ResponseMeta: meta,
-	}, nil
+	}
+	if err := fieldmask.Prune(out.Data, q.fields); err != nil {
+		return SherlockListResponse{ResponseMeta: meta}, err
+	}
+	return out, nil
 }
 
 // Results executes the query and returns the current page of Sherlocks.
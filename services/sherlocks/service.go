@@ -2,13 +2,19 @@ package sherlocks
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"strconv"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/common"
 	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/extract"
+	"github.com/gubarz/gohtb/internal/flagutil"
 	"github.com/gubarz/gohtb/internal/service"
+	"github.com/gubarz/gohtb/internal/stream"
 )
 
 type SherlockQuery struct {
@@ -22,6 +28,7 @@ type SherlockQuery struct {
 	keyword    v4Client.Keyword
 	page       int
 	perPage    int
+	fields     []string
 }
 
 type Service struct {
@@ -303,6 +310,11 @@ type OwnResponse struct {
 //	}
 //	fmt.Printf("Flag accepted: %t\n", result.Data.Success)
 func (h *Handle) Own(ctx context.Context, taskId int, flag string) (OwnResponse, error) {
+	flag, err := flagutil.Normalize(h.client, flag, flagutil.Answer)
+	if err != nil {
+		return OwnResponse{ResponseMeta: common.ResponseMeta{}}, err
+	}
+
 	body := v4Client.PostSherlockTasksFlagJSONRequestBody{
 		Flag: flag,
 	}
@@ -316,7 +328,7 @@ func (h *Handle) Own(ctx context.Context, taskId int, flag string) (OwnResponse,
 		return OwnResponse{ResponseMeta: common.ResponseMeta{}}, err
 	}
 
-	parsed, meta, err := common.Parse(resp, v4Client.ParsePostSherlockTasksFlagResponse)
+	parsed, meta, err := common.ParseAny(resp, v4Client.ParsePostSherlockTasksFlagResponse, http.StatusCreated)
 	if err != nil {
 		return OwnResponse{ResponseMeta: meta}, err
 	}
@@ -428,12 +440,63 @@ func (h *Handle) WriteupOfficial(ctx context.Context) (WriteupOfficialResponse,
 	}
 
 	return WriteupOfficialResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetSherlockWriteupOfficial"),
 	}, nil
 }
+
+type DownloadStreamResponse struct {
+	// Written is the number of bytes copied to the writer.
+	Written      int64
+	ResponseMeta common.ResponseMeta
+}
+
+// DownloadTo resolves the sherlock's download link and streams the archive to
+// w, calling progress as bytes arrive. total is taken from the Content-Length
+// header and is -1 when the server does not send one. progress may be nil.
+//
+// The archive is fetched from the signed storage URL returned by DownloadLink,
+// not from the API, so that request does not go through the client's
+// transport. ResponseMeta describes the storage response.
+//
+// Example:
+//
+//	f, err := os.Create("sherlock.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	_, err = client.Sherlocks.Sherlock(123).DownloadTo(ctx, f, func(downloaded, total int64) {
+//		fmt.Printf("\r%d / %d bytes", downloaded, total)
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (h *Handle) DownloadTo(ctx context.Context, w io.Writer, progress func(downloaded, total int64)) (DownloadStreamResponse, error) {
+	link, err := h.DownloadLink(ctx)
+	if err != nil {
+		return DownloadStreamResponse{ResponseMeta: link.ResponseMeta}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.Data.Url, nil)
+	if err != nil {
+		return DownloadStreamResponse{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		raw := extract.Raw(resp)
+		if err == nil {
+			err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		return errutil.UnwrapFailure(err, raw, common.SafeStatus(resp), func(raw []byte) DownloadStreamResponse {
+			return DownloadStreamResponse{ResponseMeta: common.ResponseMeta{Raw: raw}}
+		})
+	}
+	defer resp.Body.Close()
+
+	meta := common.NewMeta(resp, nil, "")
+
+	written, err := stream.Copy(clock.From(h.client), w, resp.Body, resp.ContentLength, progress)
+	return DownloadStreamResponse{Written: written, ResponseMeta: meta}, err
+}
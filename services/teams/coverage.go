@@ -0,0 +1,236 @@
+package teams
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// OwnState describes how far a member got on a machine.
+type OwnState int
+
+const (
+	OwnNone OwnState = iota
+	OwnUser
+	OwnRoot
+	// OwnUnknown is used when the member's activity could not be read,
+	// typically because their profile is private.
+	OwnUnknown
+)
+
+func (s OwnState) String() string {
+	switch s {
+	case OwnNone:
+		return "None"
+	case OwnUser:
+		return "User"
+	case OwnRoot:
+		return "Root"
+	default:
+		return "Unknown"
+	}
+}
+
+// CoverageRow holds one member's state for each machine in
+// CoverageMatrix.MachineIDs, in the same order.
+type CoverageRow struct {
+	UserID   int
+	Username string
+	States   []OwnState
+}
+
+// MachineCoverage counts how many members own a machine. UserOwns includes
+// members that also have root.
+type MachineCoverage struct {
+	MachineID int
+	UserOwns  int
+	RootOwns  int
+	Unknown   int
+}
+
+type CoverageMatrix struct {
+	MachineIDs []int
+	Members    []CoverageRow
+	Machines   []MachineCoverage
+	Warnings   []string
+}
+
+// CoverageMatrix builds a member × machine matrix of own states for the team.
+// Each member's activity feed is fetched with bounded parallelism through the
+// client's rate limiter.
+//
+// Members with private profiles, or whose activity cannot be read, are
+// reported with OwnUnknown for every machine and a warning; they do not fail
+// the whole call.
+//
+// Example:
+//
+//	matrix, err := client.Teams.Team(12345).CoverageMatrix(ctx, []int{601, 602, 603})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, w := range matrix.Warnings {
+//		log.Println(w)
+//	}
+//	_ = matrix.WriteCSV(os.Stdout)
+func (h *Handle) CoverageMatrix(ctx context.Context, machineIDs []int) (CoverageMatrix, error) {
+	members, err := h.Members(ctx)
+	if err != nil {
+		return CoverageMatrix{}, err
+	}
+
+	column := make(map[int]int, len(machineIDs))
+	for i, id := range machineIDs {
+		column[id] = i
+	}
+
+	warnings := make([]string, len(members.Data))
+	userService := users.NewService(h.client)
+
+	results, err := batch.Collect(ctx, len(members.Data), batch.DefaultConcurrency, func(ctx context.Context, i int) (CoverageRow, error) {
+		m := members.Data[i]
+		row := CoverageRow{
+			UserID:   m.Id,
+			Username: m.Name,
+			States:   make([]OwnState, len(machineIDs)),
+		}
+
+		if m.Public == 0 {
+			markUnknown(row.States)
+			warnings[i], _ = common.PrivateMemberWarning(m.Name, m.Id, nil)
+			return row, nil
+		}
+
+		activity, err := userService.User(m.Id).ProfileActivity().AllResults(ctx)
+		if err != nil {
+			warning, private := common.PrivateMemberWarning(m.Name, m.Id, err)
+			if !private {
+				return row, err
+			}
+			markUnknown(row.States)
+			warnings[i] = warning
+			return row, nil
+		}
+
+		for _, item := range activity.Data {
+			own, ok := item.AsMachineOwn()
+			if !ok {
+				continue
+			}
+			col, tracked := column[own.Id]
+			if !tracked {
+				continue
+			}
+			switch own.Type {
+			case "root":
+				row.States[col] = OwnRoot
+			case "user":
+				if row.States[col] == OwnNone {
+					row.States[col] = OwnUser
+				}
+			}
+		}
+		return row, nil
+	})
+	if err != nil {
+		return CoverageMatrix{}, err
+	}
+	if err := results.Err(); err != nil {
+		return CoverageMatrix{}, err
+	}
+	rows := make([]CoverageRow, len(members.Data))
+	for i, row := range results.Succeeded {
+		rows[i] = row
+	}
+
+	out := CoverageMatrix{
+		MachineIDs: machineIDs,
+		Members:    rows,
+		Machines:   make([]MachineCoverage, len(machineIDs)),
+	}
+	for i, id := range machineIDs {
+		out.Machines[i].MachineID = id
+	}
+	for _, row := range rows {
+		for col, state := range row.States {
+			switch state {
+			case OwnRoot:
+				out.Machines[col].RootOwns++
+				out.Machines[col].UserOwns++
+			case OwnUser:
+				out.Machines[col].UserOwns++
+			case OwnUnknown:
+				out.Machines[col].Unknown++
+			}
+		}
+	}
+	for _, w := range warnings {
+		if w != "" {
+			out.Warnings = append(out.Warnings, w)
+		}
+	}
+
+	return out, nil
+}
+
+// WriteCSV writes the matrix as CSV with one row per member and one column
+// per machine, followed by user and root own totals per machine.
+//
+// Example:
+//
+//	f, err := os.Create("coverage.csv")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer f.Close()
+//	if err := matrix.WriteCSV(f); err != nil {
+//		log.Fatal(err)
+//	}
+func (m CoverageMatrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"user_id", "username"}
+	for _, id := range m.MachineIDs {
+		header = append(header, strconv.Itoa(id))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range m.Members {
+		record := []string{strconv.Itoa(row.UserID), row.Username}
+		for _, state := range row.States {
+			record = append(record, state.String())
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	userTotals := []string{"", "user_owns"}
+	rootTotals := []string{"", "root_owns"}
+	for _, mc := range m.Machines {
+		userTotals = append(userTotals, strconv.Itoa(mc.UserOwns))
+		rootTotals = append(rootTotals, strconv.Itoa(mc.RootOwns))
+	}
+	if err := cw.Write(userTotals); err != nil {
+		return err
+	}
+	if err := cw.Write(rootTotals); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func markUnknown(states []OwnState) {
+	for i := range states {
+		states[i] = OwnUnknown
+	}
+}
@@ -0,0 +1,148 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/users"
+)
+
+// MemberPoints is one member's contribution to the team's points.
+type MemberPoints struct {
+	UserID          int
+	Username        string
+	MachinePoints   int
+	ChallengePoints int
+	// SeasonPoints is the member's season points summed over every season
+	// they ranked in. Season points are scored separately and are not part
+	// of Total.
+	SeasonPoints int
+	// Total is the member's points as shown on the team's member list.
+	Total int
+	// Unknown is set when the member's activity could not be read,
+	// typically because their profile is private. Only Total is filled in.
+	Unknown bool
+}
+
+type TeamPointsBreakdown struct {
+	TeamID int
+	// Members is sorted by Total, highest first.
+	Members  []MemberPoints
+	Warnings []string
+}
+
+type TeamPointsBreakdownResponse struct {
+	Data         TeamPointsBreakdown
+	ResponseMeta common.ResponseMeta
+}
+
+// PointsBreakdown reports each member's point contribution, split into
+// machine and challenge points, sorted by Total descending.
+//
+// The team's captain sees every member. Any other member sees only their own
+// breakdown, and a user who is not on the team gets ErrNotMember. Machine and
+// challenge points come from each member's activity feed, so members with
+// private profiles are listed with Unknown set and a warning rather than
+// failing the call.
+//
+// Example:
+//
+//	breakdown, err := client.Teams.Team(12345).PointsBreakdown(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range breakdown.Data.Members {
+//		fmt.Printf("%s: %d (machines %d, challenges %d)\n", m.Username, m.Total, m.MachinePoints, m.ChallengePoints)
+//	}
+func (h *Handle) PointsBreakdown(ctx context.Context) (TeamPointsBreakdownResponse, error) {
+	userService := users.NewService(h.client)
+	me, err := userService.Info(ctx)
+	if err != nil {
+		return TeamPointsBreakdownResponse{ResponseMeta: me.ResponseMeta}, err
+	}
+
+	members, err := h.Members(ctx)
+	if err != nil {
+		return TeamPointsBreakdownResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+
+	visible := make([]TeamMember, 0, len(members.Data))
+	isCaptain := false
+	for _, m := range members.Data {
+		if m.Team.CaptainId != 0 && m.Team.CaptainId == me.Data.Info.Id {
+			isCaptain = true
+		}
+		if m.Id == me.Data.Info.Id {
+			visible = append(visible, m)
+		}
+	}
+	if isCaptain {
+		visible = members.Data
+	}
+	if len(visible) == 0 {
+		return TeamPointsBreakdownResponse{ResponseMeta: members.ResponseMeta}, fmt.Errorf("%w: user %d, team %d", ErrNotMember, me.Data.Info.Id, h.id)
+	}
+
+	warnings := make([]string, len(visible))
+	results, err := batch.Collect(ctx, len(visible), batch.DefaultConcurrency, func(ctx context.Context, i int) (MemberPoints, error) {
+		m := visible[i]
+		row := MemberPoints{
+			UserID:   m.Id,
+			Username: m.Name,
+			Total:    m.Points,
+		}
+
+		if m.Public == 0 && m.Id != me.Data.Info.Id {
+			row.Unknown = true
+			warnings[i], _ = common.PrivateMemberWarning(m.Name, m.Id, nil)
+			return row, nil
+		}
+
+		breakdown, err := userService.User(m.Id).PointsBreakdown(ctx)
+		if err != nil {
+			warning, private := common.PrivateMemberWarning(m.Name, m.Id, err)
+			if !private {
+				return row, err
+			}
+			row.Unknown = true
+			warnings[i] = warning
+			return row, nil
+		}
+		row.MachinePoints = breakdown.Data.MachinePoints
+		row.ChallengePoints = breakdown.Data.ChallengePoints
+		row.SeasonPoints = breakdown.Data.SeasonPoints
+		return row, nil
+	})
+	if err != nil {
+		return TeamPointsBreakdownResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+	if err := results.Err(); err != nil {
+		return TeamPointsBreakdownResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+	rows := make([]MemberPoints, len(visible))
+	for i, row := range results.Succeeded {
+		rows[i] = row
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Total > rows[j].Total
+	})
+
+	out := TeamPointsBreakdown{
+		TeamID:  h.id,
+		Members: rows,
+	}
+	for _, w := range warnings {
+		if w != "" {
+			out.Warnings = append(out.Warnings, w)
+		}
+	}
+
+	return TeamPointsBreakdownResponse{
+		Data:         out,
+		ResponseMeta: members.ResponseMeta,
+	}, nil
+}
@@ -2,9 +2,15 @@ package teams
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/service"
 )
 
@@ -456,3 +462,130 @@ func (h *Handle) Graph(ctx context.Context, params *v4Client.GetTeamGraphParams)
 
 	return GraphResponse{Data: *parsed.JSON200, ResponseMeta: meta}, nil
 }
+
+// ErrPrivateTeam is returned when a team's data is hidden because the team is
+// private and the authenticated user is not a member.
+var ErrPrivateTeam = errors.New("team is private")
+
+type TeamEvent struct {
+	MemberID       int
+	MemberUsername string
+	EventType      string
+	ResourceType   string
+	ResourceID     int
+	ResourceName   string
+	OccurredAt     time.Time
+}
+
+type TeamActivityResponse struct {
+	Data         []TeamEvent
+	ResponseMeta common.ResponseMeta
+}
+
+// ActivityFeed retrieves the most recent member events for the team, newest first.
+// limit is capped at 100; zero or a negative value returns the maximum.
+// Public teams can be read by anyone. For private teams the caller must be a
+// member, otherwise ErrPrivateTeam is returned.
+//
+// Example:
+//
+//	feed, err := client.Teams.Team(12345).ActivityFeed(ctx, 20)
+//	if errors.Is(err, teams.ErrPrivateTeam) {
+//		log.Fatal("team is private")
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, e := range feed.Data {
+//		fmt.Printf("%s %s %s at %s\n", e.MemberUsername, e.EventType, e.ResourceName, e.OccurredAt)
+//	}
+func (h *Handle) ActivityFeed(ctx context.Context, limit int) (TeamActivityResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	activity, err := h.Activity(ctx, 90)
+	if err != nil {
+		var apiErr *errutil.APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusForbidden || apiErr.StatusCode == http.StatusNotFound) {
+			if info, infoErr := h.Info(ctx); infoErr == nil && !info.Data.Public {
+				return TeamActivityResponse{ResponseMeta: activity.ResponseMeta}, fmt.Errorf("%w: %w", ErrPrivateTeam, err)
+			}
+		}
+		return TeamActivityResponse{ResponseMeta: activity.ResponseMeta}, err
+	}
+
+	items := activity.Data
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Date.After(items[j].Date) })
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	events := make([]TeamEvent, len(items))
+	for i, item := range items {
+		events[i] = TeamEvent{
+			MemberID:       item.User.Id,
+			MemberUsername: item.User.Name,
+			EventType:      item.Type,
+			ResourceType:   item.ObjectType,
+			ResourceID:     item.Id,
+			ResourceName:   item.Name,
+			OccurredAt:     item.Date,
+		}
+	}
+
+	return TeamActivityResponse{
+		Data:         events,
+		ResponseMeta: activity.ResponseMeta,
+	}, nil
+}
+
+// ErrNotAuthorized is returned by team management calls when the
+// authenticated user is not the team's captain.
+var ErrNotAuthorized = errors.New("not authorized to manage team")
+
+// ErrNotMember is returned by RemoveMember when the user is not on the team.
+var ErrNotMember = errors.New("user is not a member of the team")
+
+// RemoveMember removes a user from this team. The authenticated user must be
+// the team's captain, otherwise ErrNotAuthorized is returned.
+//
+// Unlike Service.KickMember, the user's membership of this team is checked
+// first, so a stale ID never removes someone from a different team.
+//
+// Example:
+//
+//	result, err := client.Teams.Team(12345).RemoveMember(ctx, 54321)
+//	if errors.Is(err, teams.ErrNotAuthorized) {
+//		log.Fatal("only the captain can remove members")
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Remove result: %s\n", result.Data.Message)
+func (h *Handle) RemoveMember(ctx context.Context, userID int) (common.MessageResponse, error) {
+	members, err := h.Members(ctx)
+	if err != nil {
+		return common.MessageResponse{ResponseMeta: members.ResponseMeta}, err
+	}
+	found := false
+	for _, m := range members.Data {
+		if m.Id == userID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return common.MessageResponse{ResponseMeta: members.ResponseMeta}, fmt.Errorf("%w: user %d, team %d", ErrNotMember, userID, h.id)
+	}
+
+	result, err := NewService(h.client).KickMember(ctx, userID)
+	if err != nil {
+		var apiErr *errutil.APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+			return result, fmt.Errorf("%w: %w", ErrNotAuthorized, err)
+		}
+		return result, err
+	}
+	return result, nil
+}
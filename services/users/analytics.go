@@ -0,0 +1,216 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/ptr"
+)
+
+// MachineStats summarises a user's machine activity over a period, as
+// returned by MostActive. The most played OS and difficulty count each
+// distinct machine owned in the period once; ties go to the name that sorts
+// first, and both are empty if no machine was owned.
+//
+// Example:
+//
+//	stats, err := client.Users.User(12345).MostActive(ctx, "week")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s/%s, %.1f solves a day, streak %d (best %d)\n",
+//		stats.MostPlayedOS, stats.MostPlayedDifficulty,
+//		stats.AverageDailySolves, stats.CurrentStreak, stats.LongestStreak)
+type MachineStats struct {
+	MostPlayedOS         string
+	MostPlayedDifficulty string
+	// AverageDailySolves is the number of user and root owns in the period
+	// divided by its length in days.
+	AverageDailySolves float64
+	// LongestStreak and CurrentStreak are counted in consecutive UTC days
+	// with any activity.
+	LongestStreak int
+	CurrentStreak int
+}
+
+// MostActive summarises the user's machine activity over the given period.
+// Valid periods are "week", "month", and "year".
+//
+// The statistics are computed from the public profile activity feed. OS and
+// difficulty are looked up once per distinct machine owned in the period, so
+// long periods on very active profiles will issue more requests.
+// Streaks count consecutive UTC days with at least one activity entry inside
+// the period; CurrentStreak is zero unless the user was active today or yesterday.
+//
+// Example:
+//
+//	stats, err := client.Users.User(12345).MostActive(ctx, "month")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Favourite OS: %s, longest streak: %d days\n", stats.MostPlayedOS, stats.LongestStreak)
+func (h *Handle) MostActive(ctx context.Context, period string) (MachineStats, error) {
+	var days int
+	switch period {
+	case "week":
+		days = 7
+	case "month":
+		days = 30
+	case "year":
+		days = 365
+	default:
+		return MachineStats{}, fmt.Errorf("unsupported period %q: must be week, month or year", period)
+	}
+
+	now := clock.From(h.client).Now().UTC()
+	since := now.AddDate(0, 0, -days)
+
+	activity, _, err := h.activitySince(ctx, since)
+	if err != nil {
+		return MachineStats{}, err
+	}
+
+	osCount := map[string]int{}
+	difficultyCount := map[string]int{}
+	seen := map[int]bool{}
+	activeDays := map[time.Time]bool{}
+	solves := 0
+
+	for _, item := range activity {
+		activeDays[truncateDay(item.OwnDate)] = true
+
+		own, ok := item.AsMachineOwn()
+		if !ok {
+			continue
+		}
+		solves++
+		if seen[own.Id] {
+			continue
+		}
+		seen[own.Id] = true
+
+		info, err := h.machineProfile(ctx, own.Id)
+		if err != nil {
+			return MachineStats{}, err
+		}
+		osCount[info.Os]++
+		difficultyCount[info.DifficultyText]++
+	}
+
+	longest, current := streaks(activeDays, now)
+
+	return MachineStats{
+		MostPlayedOS:         mostFrequent(osCount),
+		MostPlayedDifficulty: mostFrequent(difficultyCount),
+		AverageDailySolves:   float64(solves) / float64(days),
+		LongestStreak:        longest,
+		CurrentStreak:        current,
+	}, nil
+}
+
+// activitySince pages through the profile activity feed until entries older
+// than since are reached and returns the entries inside the window.
+func (h *Handle) activitySince(ctx context.Context, since time.Time) (UserProfileActivityItems, common.ResponseMeta, error) {
+	q := h.ProfileActivity()
+	var out UserProfileActivityItems
+	var meta common.ResponseMeta
+
+	for {
+		resp, err := q.fetchResults(ctx)
+		if err != nil {
+			return nil, resp.ResponseMeta, err
+		}
+		meta = resp.ResponseMeta
+
+		reachedEnd := false
+		for _, item := range resp.Data {
+			if item.OwnDate.Before(since) {
+				reachedEnd = true
+				continue
+			}
+			out = append(out, item)
+		}
+
+		if reachedEnd || len(resp.Data) < q.perPage {
+			break
+		}
+		q = ptr.Clone(q)
+		q.page++
+	}
+
+	return out, meta, nil
+}
+
+func (h *Handle) machineProfile(ctx context.Context, id int) (v4Client.MachineProfileInfo, error) {
+	resp, err := h.client.V4().GetMachineProfile(h.client.Limiter().Wrap(ctx), strconv.Itoa(id))
+	if err != nil {
+		return v4Client.MachineProfileInfo{}, err
+	}
+
+	parsed, _, err := common.Parse(resp, v4Client.ParseGetMachineProfileResponse)
+	if err != nil {
+		return v4Client.MachineProfileInfo{}, err
+	}
+	return parsed.JSON200.Info, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// streaks returns the longest run of consecutive active days and the run
+// ending today (or yesterday, so an in-progress day does not break it).
+func streaks(days map[time.Time]bool, now time.Time) (longest, current int) {
+	if len(days) == 0 {
+		return 0, 0
+	}
+
+	sorted := make([]time.Time, 0, len(days))
+	for d := range days {
+		sorted = append(sorted, d)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	run := 1
+	longest = 1
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Sub(sorted[i-1]) == 24*time.Hour {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+
+	day := truncateDay(now)
+	if !days[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	for days[day] {
+		current++
+		day = day.AddDate(0, 0, -1)
+	}
+	return longest, current
+}
+
+func mostFrequent(counts map[string]int) string {
+	best, bestCount := "", 0
+	for k, n := range counts {
+		if k == "" {
+			continue
+		}
+		if n > bestCount || (n == bestCount && k < best) {
+			best, bestCount = k, n
+		}
+	}
+	return best
+}
@@ -0,0 +1,204 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/clock"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// MachineRef identifies a machine the user solved.
+type MachineRef struct {
+	ID         int
+	Name       string
+	Difficulty string
+	OwnedAt    time.Time
+}
+
+// SolveTimeRef is a root own together with how long after the machine's
+// release it happened.
+type SolveTimeRef struct {
+	MachineRef
+	Duration time.Duration
+}
+
+// SeasonRef is the user's standing in one season.
+type SeasonRef struct {
+	Points     int
+	Rank       int
+	TotalRanks int
+	League     string
+}
+
+// PersonalBests holds a user's records. Pointer fields are nil until the
+// user has achieved the corresponding record.
+type PersonalBests struct {
+	EarliestFirstBlood   *time.Time
+	HardestMachineSolved *MachineRef
+	FastestSolveTime     *SolveTimeRef
+	MostPointsInSeason   *SeasonRef
+	// LongestActiveStreak is the longest run of consecutive UTC days with
+	// at least one activity entry.
+	LongestActiveStreak int
+}
+
+type PersonalBestsResponse struct {
+	Data         PersonalBests
+	ResponseMeta common.ResponseMeta
+}
+
+var difficultyOrder = map[string]int{
+	"easy":   1,
+	"medium": 2,
+	"hard":   3,
+	"insane": 4,
+}
+
+// PersonalBests computes the user's records from their full profile
+// activity feed and their season ranks.
+//
+// Each distinct machine the user rooted is looked up once, with bounded
+// parallelism, to find its difficulty and release date, so very active
+// profiles issue more requests. The season rank endpoint does not say which
+// season a rank belongs to, so MostPointsInSeason reports the standing only.
+//
+// Example:
+//
+//	bests, err := client.Users.User(12345).PersonalBests(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if m := bests.Data.HardestMachineSolved; m != nil {
+//		fmt.Printf("Hardest machine: %s (%s)\n", m.Name, m.Difficulty)
+//	}
+//	fmt.Printf("Longest streak: %d days\n", bests.Data.LongestActiveStreak)
+func (h *Handle) PersonalBests(ctx context.Context) (PersonalBestsResponse, error) {
+	activity, meta, err := h.activitySince(ctx, time.Time{})
+	if err != nil {
+		return PersonalBestsResponse{ResponseMeta: meta}, err
+	}
+
+	var bests PersonalBests
+	activeDays := map[time.Time]bool{}
+	rooted := map[int]MachineRef{}
+
+	for _, item := range activity {
+		activeDays[truncateDay(item.OwnDate)] = true
+
+		if item.Blood && (bests.EarliestFirstBlood == nil || item.OwnDate.Before(*bests.EarliestFirstBlood)) {
+			t := item.OwnDate
+			bests.EarliestFirstBlood = &t
+		}
+
+		own, ok := item.AsMachineOwn()
+		if !ok || own.Type != v5Client.UserProfileActivityMachineOwnTypeRoot {
+			continue
+		}
+		if prev, seen := rooted[own.Id]; !seen || own.OwnDate.Before(prev.OwnedAt) {
+			rooted[own.Id] = MachineRef{ID: own.Id, Name: own.Name, OwnedAt: own.OwnDate}
+		}
+	}
+	bests.LongestActiveStreak, _ = streaks(activeDays, clock.From(h.client).Now().UTC())
+
+	refs := make([]MachineRef, 0, len(rooted))
+	for _, ref := range rooted {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].ID < refs[j].ID })
+	profiles, err := batch.Collect(ctx, len(refs), batch.DefaultConcurrency, func(ctx context.Context, i int) (v4Client.MachineProfileInfo, error) {
+		info, err := h.machineProfile(ctx, refs[i].ID)
+		if err != nil {
+			return info, fmt.Errorf("machine %d: %w", refs[i].ID, err)
+		}
+		return info, nil
+	})
+	if err != nil {
+		return PersonalBestsResponse{ResponseMeta: meta}, err
+	}
+	if err := profiles.Err(); err != nil {
+		return PersonalBestsResponse{ResponseMeta: meta}, err
+	}
+	releases := make([]time.Time, len(refs))
+	for i, info := range profiles.Succeeded {
+		refs[i].Difficulty = info.DifficultyText
+		releases[i] = info.Release
+	}
+
+	for i, ref := range refs {
+		if hardest := bests.HardestMachineSolved; hardest == nil || harder(ref, *hardest) {
+			r := ref
+			bests.HardestMachineSolved = &r
+		}
+		if releases[i].IsZero() || ref.OwnedAt.Before(releases[i]) {
+			continue
+		}
+		d := ref.OwnedAt.Sub(releases[i])
+		if fastest := bests.FastestSolveTime; fastest == nil || d < fastest.Duration {
+			bests.FastestSolveTime = &SolveTimeRef{MachineRef: ref, Duration: d}
+		}
+	}
+
+	season, err := h.bestSeason(ctx)
+	if err != nil {
+		return PersonalBestsResponse{ResponseMeta: meta}, err
+	}
+	bests.MostPointsInSeason = season
+
+	return PersonalBestsResponse{
+		Data:         bests,
+		ResponseMeta: meta,
+	}, nil
+}
+
+// harder orders machines by difficulty, then by earliest own, so the
+// result is stable across calls.
+func harder(a, b MachineRef) bool {
+	da := difficultyOrder[strings.ToLower(a.Difficulty)]
+	db := difficultyOrder[strings.ToLower(b.Difficulty)]
+	if da != db {
+		return da > db
+	}
+	return a.OwnedAt.Before(b.OwnedAt)
+}
+
+func (h *Handle) bestSeason(ctx context.Context) (*SeasonRef, error) {
+	ranks, err := h.seasonRanks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *SeasonRef
+	for _, r := range ranks {
+		if r.TotalSeasonPoints <= 0 || (best != nil && r.TotalSeasonPoints <= best.Points) {
+			continue
+		}
+		best = &SeasonRef{
+			Points:     r.TotalSeasonPoints,
+			Rank:       r.Rank,
+			TotalRanks: r.TotalRanks,
+			League:     r.League,
+		}
+	}
+	return best, nil
+}
+
+// seasonRanks returns the user's standing in every season they ranked in.
+func (h *Handle) seasonRanks(ctx context.Context) (v4Client.SeasonUserRankDataItems, error) {
+	resp, err := h.client.V4().GetSeasonUserUserIdRank(h.client.Limiter().Wrap(ctx), h.id)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, _, err := common.Parse(resp, v4Client.ParseGetSeasonUserUserIdRankResponse)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.JSON200.Data, nil
+}
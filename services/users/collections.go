@@ -0,0 +1,145 @@
+package users
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// CollectionBadge is the user's progress towards rooting every machine of
+// one operating system and difficulty.
+type CollectionBadge struct {
+	OS             string
+	Difficulty     string
+	TotalMachines  int
+	SolvedMachines int
+	Percentage     float64
+	// EarnedAt is the root own that completed the collection, or nil while
+	// it is incomplete.
+	EarnedAt *time.Time
+}
+
+type CollectionBadgesResponse struct {
+	Data         []CollectionBadge
+	ResponseMeta common.ResponseMeta
+}
+
+const catalogPageSize = 100
+
+// MachineCollectionBadges reports, for every operating system and
+// difficulty, how many of the active and retired machines the user has
+// rooted. A collection is complete when Percentage is 100 and EarnedAt is
+// set.
+//
+// Totals come from the machine catalog and solves from the user's full
+// activity feed, so both are paged through in full. Results are ordered by
+// OS, then from Easy to Insane.
+//
+// Example:
+//
+//	badges, err := client.Users.User(12345).MachineCollectionBadges(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, b := range badges.Data {
+//		fmt.Printf("%s %s: %d/%d (%.0f%%)\n", b.OS, b.Difficulty, b.SolvedMachines, b.TotalMachines, b.Percentage)
+//	}
+func (h *Handle) MachineCollectionBadges(ctx context.Context) (CollectionBadgesResponse, error) {
+	catalog, meta, err := h.machineCatalog(ctx)
+	if err != nil {
+		return CollectionBadgesResponse{ResponseMeta: meta}, err
+	}
+
+	activity, meta, err := h.activitySince(ctx, time.Time{})
+	if err != nil {
+		return CollectionBadgesResponse{ResponseMeta: meta}, err
+	}
+	rooted := map[int]time.Time{}
+	for _, item := range activity {
+		own, ok := item.AsMachineOwn()
+		if !ok || own.Type != v5Client.UserProfileActivityMachineOwnTypeRoot {
+			continue
+		}
+		if prev, seen := rooted[own.Id]; !seen || own.OwnDate.Before(prev) {
+			rooted[own.Id] = own.OwnDate
+		}
+	}
+
+	type key struct{ os, difficulty string }
+	groups := map[key]*CollectionBadge{}
+	latest := map[key]time.Time{}
+	for _, m := range catalog {
+		k := key{m.Os, m.DifficultyText}
+		b := groups[k]
+		if b == nil {
+			b = &CollectionBadge{OS: m.Os, Difficulty: m.DifficultyText}
+			groups[k] = b
+		}
+		b.TotalMachines++
+		if at, ok := rooted[m.Id]; ok {
+			b.SolvedMachines++
+			if at.After(latest[k]) {
+				latest[k] = at
+			}
+		}
+	}
+
+	badges := make([]CollectionBadge, 0, len(groups))
+	for k, b := range groups {
+		b.Percentage = float64(b.SolvedMachines) * 100 / float64(b.TotalMachines)
+		if b.SolvedMachines == b.TotalMachines {
+			at := latest[k]
+			b.EarnedAt = &at
+		}
+		badges = append(badges, *b)
+	}
+	sort.Slice(badges, func(i, j int) bool {
+		if !strings.EqualFold(badges[i].OS, badges[j].OS) {
+			return strings.ToLower(badges[i].OS) < strings.ToLower(badges[j].OS)
+		}
+		di := difficultyOrder[strings.ToLower(badges[i].Difficulty)]
+		dj := difficultyOrder[strings.ToLower(badges[j].Difficulty)]
+		if di != dj {
+			return di < dj
+		}
+		return badges[i].Difficulty < badges[j].Difficulty
+	})
+
+	return CollectionBadgesResponse{
+		Data:         badges,
+		ResponseMeta: meta,
+	}, nil
+}
+
+// machineCatalog lists every active and retired machine.
+func (h *Handle) machineCatalog(ctx context.Context) ([]v5Client.MachinesItem, common.ResponseMeta, error) {
+	state := v5Client.State{"active", "retired"}
+	perPage := catalogPageSize
+	var all []v5Client.MachinesItem
+	var meta common.ResponseMeta
+	for page := 1; ; page++ {
+		p := page
+		resp, err := h.client.V5().GetMachines(h.client.Limiter().Wrap(ctx), &v5Client.GetMachinesParams{
+			Page:    &p,
+			PerPage: &perPage,
+			State:   &state,
+		})
+		if err != nil {
+			return nil, common.ResponseMeta{}, err
+		}
+		parsed, m, err := common.Parse(resp, v5Client.ParseGetMachinesResponse)
+		meta = m
+		if err != nil {
+			return nil, meta, err
+		}
+		all = append(all, parsed.JSON200.Data...)
+		if len(parsed.JSON200.Data) < perPage {
+			break
+		}
+	}
+	return all, meta, nil
+}
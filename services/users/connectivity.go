@@ -0,0 +1,94 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/services/vpn"
+)
+
+// ConnectivityStatus is a snapshot of the user's VPN connection for
+// troubleshooting.
+type ConnectivityStatus struct {
+	VPNConnected bool
+	// VPNServer is the friendly name of the server the connection is on,
+	// falling back to its hostname.
+	VPNServer string
+	// LastSeenAt is when the VPN last saw traffic from the user. The API
+	// does not report it yet, so it is always nil.
+	LastSeenAt *time.Time
+	// IPAddress is the address assigned on the VPN, IPv4 if there is one.
+	// It can be nil even while connected.
+	IPAddress *string
+}
+
+type ConnectivityCheckResponse struct {
+	Data         ConnectivityStatus
+	ResponseMeta common.ResponseMeta
+}
+
+// ConnectivityCheck reports whether the user is connected to the VPN, and
+// if so on which server and with which address. It only reads the
+// connection status and changes nothing. The API reports connections for
+// the authenticated user only, so ErrNotAuthenticatedUser is returned for
+// any other handle.
+//
+// When several connections are up, such as a lab and a Pro Lab, the first
+// one the API lists is reported.
+//
+// Example:
+//
+//	me, err := client.Users.Info(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	check, err := client.Users.User(me.Data.Info.Id).ConnectivityCheck(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if !check.Data.VPNConnected {
+//		fmt.Println("Not connected to the VPN")
+//		return
+//	}
+//	fmt.Printf("Connected to %s\n", check.Data.VPNServer)
+//	if check.Data.IPAddress != nil {
+//		fmt.Printf("VPN address: %s\n", *check.Data.IPAddress)
+//	}
+func (h *Handle) ConnectivityCheck(ctx context.Context) (ConnectivityCheckResponse, error) {
+	me, err := NewService(h.client).Info(ctx)
+	if err != nil {
+		return ConnectivityCheckResponse{ResponseMeta: me.ResponseMeta}, err
+	}
+	if me.Data.Info.Id != h.id {
+		return ConnectivityCheckResponse{ResponseMeta: me.ResponseMeta}, fmt.Errorf("connectivity check for user %d: %w", h.id, ErrNotAuthenticatedUser)
+	}
+
+	status, err := vpn.NewService(h.client).Status(ctx)
+	if err != nil {
+		return ConnectivityCheckResponse{ResponseMeta: status.ResponseMeta}, err
+	}
+
+	var out ConnectivityStatus
+	if len(status.Data) > 0 {
+		conn := status.Data[0]
+		out.VPNConnected = true
+		out.VPNServer = conn.Server.FriendlyName
+		if out.VPNServer == "" {
+			out.VPNServer = conn.Server.Hostname
+		}
+		ip := conn.Connection.Ip4
+		if ip == "" {
+			ip = conn.Connection.Ip6
+		}
+		if ip != "" {
+			out.IPAddress = &ip
+		}
+	}
+
+	return ConnectivityCheckResponse{
+		Data:         out,
+		ResponseMeta: status.ResponseMeta,
+	}, nil
+}
@@ -3,6 +3,8 @@ package users
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	v5Client "github.com/gubarz/gohtb/httpclient/v5"
 	"github.com/gubarz/gohtb/internal/common"
@@ -15,8 +17,27 @@ type UserProfileActivityQuery struct {
 	page    int
 	perPage int
 	id      int
+	types   []ActivityType
+	since   time.Time
+	until   time.Time
 }
 
+// ActivityType selects a kind of activity entry for UserProfileActivityQuery.Type.
+type ActivityType string
+
+const (
+	// ActivityMachine matches user and root machine owns.
+	ActivityMachine   ActivityType = "machine"
+	ActivityChallenge ActivityType = "challenge"
+	ActivityFortress  ActivityType = "fortress"
+	ActivityProlab    ActivityType = "prolab"
+	ActivitySherlock  ActivityType = "sherlock"
+)
+
+// activityPageSize is the page size used to scan the feed when filters are
+// applied client-side.
+const activityPageSize = 100
+
 // Page sets the page number for the activity query.
 //
 // Example:
@@ -79,6 +100,67 @@ func (q *UserProfileActivityQuery) Previous() *UserProfileActivityQuery {
 	return qc
 }
 
+// Type restricts the query to the given kinds of activity. Calling it
+// again replaces the previous selection.
+//
+// The API cannot filter activity, so Type, Since and Until are applied
+// client-side: the feed is scanned newest first and Page and PerPage then
+// count matching entries only. Scanning stops as soon as entries older than
+// Since are reached.
+//
+// Example:
+//
+//	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+//	owns, err := client.Users.User(12345).ProfileActivity().
+//		Type(users.ActivityMachine).
+//		Since(start).
+//		Until(start.AddDate(0, 1, 0)).
+//		AllResults(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Machine owns in March: %d\n", len(owns.Data))
+func (q *UserProfileActivityQuery) Type(types ...ActivityType) *UserProfileActivityQuery {
+	qc := ptr.Clone(q)
+	qc.types = append([]ActivityType(nil), types...)
+	return qc
+}
+
+// Since keeps only entries at or after t. See Type for how filters are
+// applied.
+//
+// Example:
+//
+//	recent, err := client.Users.User(12345).ProfileActivity().
+//		Since(time.Now().AddDate(0, 0, -7)).
+//		Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Entries this week: %d\n", len(recent.Data))
+func (q *UserProfileActivityQuery) Since(t time.Time) *UserProfileActivityQuery {
+	qc := ptr.Clone(q)
+	qc.since = t
+	return qc
+}
+
+// Until keeps only entries before t. See Type for how filters are applied.
+//
+// Example:
+//
+//	older, err := client.Users.User(12345).ProfileActivity().
+//		Until(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)).
+//		Results(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Entries before 2024: %d\n", len(older.Data))
+func (q *UserProfileActivityQuery) Until(t time.Time) *UserProfileActivityQuery {
+	qc := ptr.Clone(q)
+	qc.until = t
+	return qc
+}
+
 type UserProfileActivityItems []UserProfileActivity
 
 type UserProfileActivityResponse struct {
@@ -96,6 +178,10 @@ type UserProfileActivityResponse struct {
 //	}
 //	fmt.Printf("Activity items: %d\n", len(activity.Data))
 func (q *UserProfileActivityQuery) Results(ctx context.Context) (UserProfileActivityResponse, error) {
+	if q.filtered() {
+		skip := max(q.page-1, 0) * q.perPage
+		return q.scanFiltered(ctx, skip, q.perPage)
+	}
 	return q.fetchResults(ctx)
 }
 
@@ -109,6 +195,10 @@ func (q *UserProfileActivityQuery) Results(ctx context.Context) (UserProfileActi
 //	}
 //	fmt.Printf("Total activity items: %d\n", len(activity.Data))
 func (q *UserProfileActivityQuery) AllResults(ctx context.Context) (UserProfileActivityResponse, error) {
+	if q.filtered() {
+		return q.scanFiltered(ctx, 0, -1)
+	}
+
 	var all []UserProfileActivity
 	page := 1
 	var meta common.ResponseMeta
@@ -139,6 +229,65 @@ func (q *UserProfileActivityQuery) AllResults(ctx context.Context) (UserProfileA
 	}, nil
 }
 
+func (q *UserProfileActivityQuery) filtered() bool {
+	return len(q.types) > 0 || !q.since.IsZero() || !q.until.IsZero()
+}
+
+// scanFiltered pages through the raw feed, skipping the first skip matching
+// entries and returning up to limit after them; a negative limit returns
+// every match.
+func (q *UserProfileActivityQuery) scanFiltered(ctx context.Context, skip, limit int) (UserProfileActivityResponse, error) {
+	out := UserProfileActivityItems{}
+	var meta common.ResponseMeta
+
+	raw := ptr.Clone(q)
+	raw.perPage = activityPageSize
+	for raw.page = 1; ; raw.page++ {
+		resp, err := raw.fetchResults(ctx)
+		if err != nil {
+			return UserProfileActivityResponse{ResponseMeta: resp.ResponseMeta}, err
+		}
+		meta = resp.ResponseMeta
+
+		for _, item := range resp.Data {
+			if !q.since.IsZero() && item.OwnDate.Before(q.since) {
+				return UserProfileActivityResponse{Data: out, ResponseMeta: meta}, nil
+			}
+			if !q.matches(item) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			out = append(out, item)
+			if limit >= 0 && len(out) >= limit {
+				return UserProfileActivityResponse{Data: out, ResponseMeta: meta}, nil
+			}
+		}
+
+		if len(resp.Data) < raw.perPage {
+			break
+		}
+	}
+
+	return UserProfileActivityResponse{Data: out, ResponseMeta: meta}, nil
+}
+
+func (q *UserProfileActivityQuery) matches(item UserProfileActivity) bool {
+	if !q.until.IsZero() && !item.OwnDate.Before(q.until) {
+		return false
+	}
+	if len(q.types) == 0 {
+		return true
+	}
+	kind := ActivityType(item.Type)
+	if item.Type == "user" || item.Type == "root" {
+		kind = ActivityMachine
+	}
+	return slices.Contains(q.types, kind)
+}
+
 func (q *UserProfileActivityQuery) fetchResults(ctx context.Context) (UserProfileActivityResponse, error) {
 	params := &v5Client.GetUserProfileActivityParams{
 		Page:    &q.page,
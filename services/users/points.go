@@ -0,0 +1,125 @@
+package users
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// PointsTransaction is one point-awarding entry from the activity feed.
+type PointsTransaction struct {
+	At time.Time
+	// Type is the activity type: "user" or "root" for machine owns, or
+	// "challenge", "fortress", "prolab" or "sherlock".
+	Type   string
+	ID     int
+	Name   string
+	Points int
+	Blood  bool
+}
+
+// PointsBreakdown splits a user's points by source.
+type PointsBreakdown struct {
+	MachinePoints   int
+	ChallengePoints int
+	// SeasonPoints is the user's season points summed over every season
+	// they ranked in. Seasons are scored separately, so these points are
+	// not part of ReportedTotal.
+	SeasonPoints int
+	// OtherPoints covers fortress, prolab and sherlock entries.
+	OtherPoints int
+	// SeasonBonusPoints, SpecialEventPoints and Deductions are reserved for
+	// sources the API does not report yet and are currently always nil; any
+	// such points show up in Unaccounted instead.
+	SeasonBonusPoints  *int
+	SpecialEventPoints *int
+	Deductions         *int
+	// ReportedTotal is the profile's points total as shown by the API.
+	ReportedTotal int
+	// Unaccounted is ReportedTotal minus the points of all transactions.
+	Unaccounted  int
+	Transactions []PointsTransaction
+}
+
+type PointsBreakdownResponse struct {
+	Data         PointsBreakdown
+	ResponseMeta common.ResponseMeta
+}
+
+// PointsBreakdown lists every point-awarding entry in the user's activity
+// feed, oldest first, and totals them by source.
+//
+// Activity points reflect what each own was worth when it happened, while
+// the profile total reflects current values, for example after a machine
+// retires. The difference is reported as Unaccounted rather than guessed at.
+// Season points come from the user's season ranks, which costs one more
+// request.
+//
+// Example:
+//
+//	breakdown, err := client.Users.User(12345).PointsBreakdown(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	b := breakdown.Data
+//	fmt.Printf("Machines: %d, challenges: %d, other: %d, season: %d, unaccounted: %d\n",
+//		b.MachinePoints, b.ChallengePoints, b.OtherPoints, b.SeasonPoints, b.Unaccounted)
+func (h *Handle) PointsBreakdown(ctx context.Context) (PointsBreakdownResponse, error) {
+	profile, err := h.ProfileBasic(ctx)
+	if err != nil {
+		return PointsBreakdownResponse{ResponseMeta: profile.ResponseMeta}, err
+	}
+
+	activity, meta, err := h.activitySince(ctx, time.Time{})
+	if err != nil {
+		return PointsBreakdownResponse{ResponseMeta: meta}, err
+	}
+
+	b := PointsBreakdown{
+		ReportedTotal: profile.Data.Points,
+		Transactions:  []PointsTransaction{},
+	}
+	earned := 0
+	for _, item := range activity {
+		if item.Points == 0 {
+			continue
+		}
+		b.Transactions = append(b.Transactions, PointsTransaction{
+			At:     item.OwnDate,
+			Type:   item.Type,
+			ID:     item.Id,
+			Name:   item.Name,
+			Points: item.Points,
+			Blood:  item.Blood,
+		})
+		earned += item.Points
+
+		switch item.Type {
+		case "user", "root":
+			b.MachinePoints += item.Points
+		case "challenge":
+			b.ChallengePoints += item.Points
+		default:
+			b.OtherPoints += item.Points
+		}
+	}
+	sort.SliceStable(b.Transactions, func(i, j int) bool {
+		return b.Transactions[i].At.Before(b.Transactions[j].At)
+	})
+	b.Unaccounted = b.ReportedTotal - earned
+
+	ranks, err := h.seasonRanks(ctx)
+	if err != nil {
+		return PointsBreakdownResponse{ResponseMeta: meta}, err
+	}
+	for _, r := range ranks {
+		b.SeasonPoints += r.TotalSeasonPoints
+	}
+
+	return PointsBreakdownResponse{
+		Data:         b,
+		ResponseMeta: meta,
+	}, nil
+}
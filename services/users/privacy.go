@@ -0,0 +1,55 @@
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// PrivacySettings are the visibility settings of the authenticated user's
+// profile. The API only reports whether the profile is public.
+type PrivacySettings struct {
+	ProfilePublic bool
+}
+
+type PrivacySettingsResponse struct {
+	Data         PrivacySettings
+	ResponseMeta common.ResponseMeta
+}
+
+// PrivacySettings returns the user's privacy settings, read from their
+// account settings. Settings are only served for the authenticated user,
+// so ErrNotAuthenticatedUser is returned for any other handle.
+//
+// Example:
+//
+//	me, err := client.Users.Info(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	privacy, err := client.Users.User(me.Data.Info.Id).PrivacySettings(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Public profile: %t\n", privacy.Data.ProfilePublic)
+func (h *Handle) PrivacySettings(ctx context.Context) (PrivacySettingsResponse, error) {
+	svc := NewService(h.client)
+	me, err := svc.Info(ctx)
+	if err != nil {
+		return PrivacySettingsResponse{ResponseMeta: me.ResponseMeta}, err
+	}
+	if me.Data.Info.Id != h.id {
+		return PrivacySettingsResponse{ResponseMeta: me.ResponseMeta}, fmt.Errorf("privacy settings for user %d: %w", h.id, ErrNotAuthenticatedUser)
+	}
+
+	settings, err := svc.Settings(ctx)
+	if err != nil {
+		return PrivacySettingsResponse{ResponseMeta: settings.ResponseMeta}, err
+	}
+
+	return PrivacySettingsResponse{
+		Data:         PrivacySettings{ProfilePublic: settings.Data.Public == 1},
+		ResponseMeta: settings.ResponseMeta,
+	}, nil
+}
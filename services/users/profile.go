@@ -0,0 +1,84 @@
+package users
+
+import (
+	"context"
+
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// Profile is the user's basic profile together with the content they created.
+type Profile struct {
+	UserProfile
+	// MachinesCreated lists the IDs of machines the user authored or
+	// co-authored. It is empty, not nil, for users who created none.
+	MachinesCreated []int
+}
+
+type ProfileResponse struct {
+	Data         Profile
+	ResponseMeta common.ResponseMeta
+}
+
+const profileContentPageSize = 100
+
+// Profile retrieves the user's basic profile and the IDs of the machines
+// they created. Created machines are read from the profile content feed,
+// one request per page.
+//
+// Example:
+//
+//	profile, err := client.Users.User(12345).Profile(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("%s created %d machines\n", profile.Data.Name, len(profile.Data.MachinesCreated))
+func (h *Handle) Profile(ctx context.Context) (ProfileResponse, error) {
+	basic, err := h.ProfileBasic(ctx)
+	if err != nil {
+		return ProfileResponse{ResponseMeta: basic.ResponseMeta}, err
+	}
+
+	created, err := h.machinesCreated(ctx)
+	if err != nil {
+		return ProfileResponse{ResponseMeta: basic.ResponseMeta}, err
+	}
+
+	return ProfileResponse{
+		Data: Profile{
+			UserProfile:     basic.Data,
+			MachinesCreated: created,
+		},
+		ResponseMeta: basic.ResponseMeta,
+	}, nil
+}
+
+func (h *Handle) machinesCreated(ctx context.Context) ([]int, error) {
+	ids := []int{}
+	perPage := profileContentPageSize
+
+	for page := 1; ; page++ {
+		resp, err := h.ProfileContent(ctx, &v5Client.GetUserProfileContentParams{
+			Type:    ContentTypeMachine,
+			Page:    &page,
+			PerPage: &perPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Data.Data {
+			m, err := item.AsUserProfileContentItemMachine()
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, m.Id)
+		}
+
+		if len(resp.Data.Data) == 0 || page >= resp.Data.Meta.LastPage {
+			break
+		}
+	}
+
+	return ids, nil
+}
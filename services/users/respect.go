@@ -0,0 +1,35 @@
+package users
+
+import (
+	"context"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+type IsRespectedResponse struct {
+	Data         bool
+	ResponseMeta common.ResponseMeta
+}
+
+// IsRespected reports whether the authenticated user has already given
+// respect to this user, as shown on their basic profile.
+//
+// Example:
+//
+//	respected, err := client.Users.User(12345).IsRespected(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if !respected.Data {
+//		_, err = client.Users.User(12345).Respect(ctx)
+//	}
+func (h *Handle) IsRespected(ctx context.Context) (IsRespectedResponse, error) {
+	profile, err := h.ProfileBasic(ctx)
+	if err != nil {
+		return IsRespectedResponse{ResponseMeta: profile.ResponseMeta}, err
+	}
+	return IsRespectedResponse{
+		Data:         profile.Data.IsRespected,
+		ResponseMeta: profile.ResponseMeta,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/batch"
+)
+
+// SolvedMachine is a machine the user owned, with the details used to rank
+// it in TopSolvedMachines.
+type SolvedMachine struct {
+	MachineRef
+	Rooted    bool
+	UserBlood bool
+	RootBlood bool
+	// UserOwnsCount and RootOwnsCount are the machine's global own counts.
+	// The API does not report how many players attempted a machine, so
+	// these stand in for its solve rate.
+	UserOwnsCount int
+	RootOwnsCount int
+}
+
+// MachineScorer assigns a showcase score to a solved machine. Higher
+// scores rank first.
+type MachineScorer func(m SolvedMachine) float64
+
+type topMachinesOptions struct {
+	scorer MachineScorer
+}
+
+// TopMachinesOption configures TopSolvedMachines.
+type TopMachinesOption func(*topMachinesOptions)
+
+// WithMachineScorer replaces the default ranking of TopSolvedMachines.
+func WithMachineScorer(fn MachineScorer) TopMachinesOption {
+	return func(o *topMachinesOptions) {
+		if fn != nil {
+			o.scorer = fn
+		}
+	}
+}
+
+// DefaultMachineScorer favours harder machines, then first bloods, then
+// machines few players have rooted. Difficulty is worth 10 points per step
+// from easy to insane, a root blood 25 and a user blood 10, and rarity up
+// to 10 more, falling off with the logarithm of the root own count.
+func DefaultMachineScorer(m SolvedMachine) float64 {
+	score := 10 * float64(difficultyOrder[strings.ToLower(m.Difficulty)])
+	switch {
+	case m.RootBlood:
+		score += 25
+	case m.UserBlood:
+		score += 10
+	}
+	return score + 10/(1+math.Log10(1+float64(m.RootOwnsCount)))
+}
+
+// TopSolvedMachines returns the n machines from the user's activity feed that
+// make the best showcase, highest score first. n of zero or less returns all
+// of them. Scores come from DefaultMachineScorer unless WithMachineScorer is
+// given; ties are broken by difficulty, then by earliest own.
+//
+// Each distinct machine is looked up once, with bounded parallelism, for its
+// difficulty and own counts.
+//
+// Example:
+//
+//	top, err := client.Users.User(12345).TopSolvedMachines(ctx, 3)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, m := range top {
+//		fmt.Printf("%s (%s)\n", m.Name, m.Difficulty)
+//	}
+func (h *Handle) TopSolvedMachines(ctx context.Context, n int, opts ...TopMachinesOption) ([]MachineRef, error) {
+	o := topMachinesOptions{scorer: DefaultMachineScorer}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	activity, _, err := h.activitySince(ctx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	index := map[int]int{}
+	var solved []SolvedMachine
+	for _, item := range activity {
+		own, ok := item.AsMachineOwn()
+		if !ok {
+			continue
+		}
+		i, seen := index[own.Id]
+		if !seen {
+			i = len(solved)
+			index[own.Id] = i
+			solved = append(solved, SolvedMachine{MachineRef: MachineRef{ID: own.Id, Name: own.Name, OwnedAt: own.OwnDate}})
+		}
+		m := &solved[i]
+		if own.OwnDate.Before(m.OwnedAt) {
+			m.OwnedAt = own.OwnDate
+		}
+		switch own.Type {
+		case v5Client.UserProfileActivityMachineOwnTypeRoot:
+			m.Rooted = true
+			m.RootBlood = m.RootBlood || own.Blood
+		case v5Client.UserProfileActivityMachineOwnTypeUser:
+			m.UserBlood = m.UserBlood || own.Blood
+		}
+	}
+
+	profiles, err := batch.Collect(ctx, len(solved), batch.DefaultConcurrency, func(ctx context.Context, i int) (v4Client.MachineProfileInfo, error) {
+		info, err := h.machineProfile(ctx, solved[i].ID)
+		if err != nil {
+			return info, fmt.Errorf("machine %d: %w", solved[i].ID, err)
+		}
+		return info, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := profiles.Err(); err != nil {
+		return nil, err
+	}
+	for i, info := range profiles.Succeeded {
+		solved[i].Difficulty = info.DifficultyText
+		solved[i].UserOwnsCount = info.UserOwnsCount
+		solved[i].RootOwnsCount = info.RootOwnsCount
+	}
+
+	scores := make(map[int]float64, len(solved))
+	for _, m := range solved {
+		scores[m.ID] = o.scorer(m)
+	}
+	sort.SliceStable(solved, func(i, j int) bool {
+		si, sj := scores[solved[i].ID], scores[solved[j].ID]
+		if si != sj {
+			return si > sj
+		}
+		return harder(solved[i].MachineRef, solved[j].MachineRef)
+	})
+
+	if n <= 0 || n > len(solved) {
+		n = len(solved)
+	}
+	top := make([]MachineRef, n)
+	for i := range top {
+		top[i] = solved[i].MachineRef
+	}
+	return top, nil
+}
@@ -0,0 +1,154 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v5Client "github.com/gubarz/gohtb/httpclient/v5"
+	"github.com/gubarz/gohtb/internal/batch"
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// ErrNotAuthenticatedUser is returned by calls that rely on fields the API
+// only reports for the authenticated user, when the handle is for someone
+// else.
+var ErrNotAuthenticatedUser = errors.New("only available for the authenticated user")
+
+// SpeedrunPosition is the user's best placement on machines of one
+// difficulty.
+type SpeedrunPosition struct {
+	// Rank is the user's own rank on the machine: 1 means they were the
+	// first to root it.
+	Rank int
+	// BestTime is the time from the machine's release to the user's root
+	// own.
+	BestTime time.Duration
+	MachineRef
+}
+
+// SpeedrunRank holds the user's best placement per difficulty. A nil tier
+// means the user has no ranked root own at that difficulty.
+type SpeedrunRank struct {
+	EasyRank   *SpeedrunPosition
+	MediumRank *SpeedrunPosition
+	HardRank   *SpeedrunPosition
+	InsaneRank *SpeedrunPosition
+}
+
+type SpeedrunRankResponse struct {
+	Data         SpeedrunRank
+	ResponseMeta common.ResponseMeta
+}
+
+// SpeedrunRank reports, for each machine difficulty, the machine on which
+// the authenticated user placed best: the lowest own rank, with ties going
+// to the shorter time from release to root.
+//
+// HTB has no speedrun leaderboard, so placements are taken from the own rank
+// the machine profile reports for the authenticated user. The handle must
+// therefore be the authenticated user's, otherwise ErrNotAuthenticatedUser
+// is returned. Every rooted machine is looked up once, with bounded
+// parallelism.
+//
+// Example:
+//
+//	me, err := client.Users.Info(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	ranks, err := client.Users.User(me.Data.Info.Id).SpeedrunRank(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if p := ranks.Data.HardRank; p != nil {
+//		fmt.Printf("Best hard placement: #%d on %s after %s\n", p.Rank, p.Name, p.BestTime)
+//	}
+func (h *Handle) SpeedrunRank(ctx context.Context) (SpeedrunRankResponse, error) {
+	me, err := NewService(h.client).Info(ctx)
+	if err != nil {
+		return SpeedrunRankResponse{ResponseMeta: me.ResponseMeta}, err
+	}
+	if me.Data.Info.Id != h.id {
+		return SpeedrunRankResponse{ResponseMeta: me.ResponseMeta}, fmt.Errorf("speedrun rank for user %d: %w", h.id, ErrNotAuthenticatedUser)
+	}
+
+	activity, meta, err := h.activitySince(ctx, time.Time{})
+	if err != nil {
+		return SpeedrunRankResponse{ResponseMeta: meta}, err
+	}
+
+	rooted := map[int]MachineRef{}
+	for _, item := range activity {
+		own, ok := item.AsMachineOwn()
+		if !ok || own.Type != v5Client.UserProfileActivityMachineOwnTypeRoot {
+			continue
+		}
+		if prev, seen := rooted[own.Id]; !seen || own.OwnDate.Before(prev.OwnedAt) {
+			rooted[own.Id] = MachineRef{ID: own.Id, Name: own.Name, OwnedAt: own.OwnDate}
+		}
+	}
+	refs := make([]MachineRef, 0, len(rooted))
+	for _, ref := range rooted {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].ID < refs[j].ID })
+
+	result, err := batch.Collect(ctx, len(refs), batch.DefaultConcurrency, func(ctx context.Context, i int) (*SpeedrunPosition, error) {
+		info, err := h.machineProfile(ctx, refs[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("machine %d: %w", refs[i].ID, err)
+		}
+		if info.OwnRank <= 0 || info.Release.IsZero() || refs[i].OwnedAt.Before(info.Release) {
+			return nil, nil
+		}
+		ref := refs[i]
+		ref.Difficulty = info.DifficultyText
+		return &SpeedrunPosition{
+			Rank:       info.OwnRank,
+			BestTime:   ref.OwnedAt.Sub(info.Release),
+			MachineRef: ref,
+		}, nil
+	})
+	if err != nil {
+		return SpeedrunRankResponse{ResponseMeta: meta}, err
+	}
+	if err := result.Err(); err != nil {
+		return SpeedrunRankResponse{ResponseMeta: meta}, err
+	}
+	positions := make([]*SpeedrunPosition, len(refs))
+	for i, p := range result.Succeeded {
+		positions[i] = p
+	}
+
+	var ranks SpeedrunRank
+	for _, p := range positions {
+		if p == nil {
+			continue
+		}
+		var slot **SpeedrunPosition
+		switch strings.ToLower(p.Difficulty) {
+		case "easy":
+			slot = &ranks.EasyRank
+		case "medium":
+			slot = &ranks.MediumRank
+		case "hard":
+			slot = &ranks.HardRank
+		case "insane":
+			slot = &ranks.InsaneRank
+		default:
+			continue
+		}
+		if best := *slot; best == nil || p.Rank < best.Rank || (p.Rank == best.Rank && p.BestTime < best.BestTime) {
+			*slot = p
+		}
+	}
+
+	return SpeedrunRankResponse{
+		Data:         ranks,
+		ResponseMeta: meta,
+	}, nil
+}
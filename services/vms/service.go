@@ -4,7 +4,9 @@ import (
 	"context"
 
 	v4Client "github.com/gubarz/gohtb/httpclient/v4"
+	"github.com/gubarz/gohtb/internal/clock"
 	"github.com/gubarz/gohtb/internal/common"
+	"github.com/gubarz/gohtb/internal/errutil"
 	"github.com/gubarz/gohtb/internal/service"
 )
 
@@ -61,6 +63,12 @@ type Response struct {
 //	}
 //	fmt.Printf("Reset result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Reset(ctx context.Context) (Response, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return Response{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	params := v4Client.PostVMResetJSONRequestBody{
 		MachineId: h.id,
 	}
@@ -71,8 +79,9 @@ func (h *Handle) Reset(ctx context.Context) (Response, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostVMResetResponse)
+	meta.QueueWait = wait
 	if err != nil {
-		return Response{ResponseMeta: meta}, err
+		return Response{ResponseMeta: meta}, errutil.InstanceLimit(err, meta.Headers, meta.Raw, clock.From(h.client).Now())
 	}
 
 	return Response{
@@ -95,6 +104,12 @@ func (h *Handle) Reset(ctx context.Context) (Response, error) {
 //	}
 //	fmt.Printf("Spawn result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Spawn(ctx context.Context) (Response, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return Response{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	params := v4Client.PostVMSpawnJSONRequestBody{
 		MachineId: h.id,
 	}
@@ -104,8 +119,9 @@ func (h *Handle) Spawn(ctx context.Context) (Response, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostVMSpawnResponse)
+	meta.QueueWait = wait
 	if err != nil {
-		return Response{ResponseMeta: meta}, err
+		return Response{ResponseMeta: meta}, errutil.InstanceLimit(err, meta.Headers, meta.Raw, clock.From(h.client).Now())
 	}
 
 	return Response{
@@ -128,6 +144,12 @@ func (h *Handle) Spawn(ctx context.Context) (Response, error) {
 //	}
 //	fmt.Printf("Extend result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Extend(ctx context.Context) (Response, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return Response{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	params := v4Client.PostVMExtendJSONRequestBody{
 		MachineId: h.id,
 	}
@@ -137,8 +159,9 @@ func (h *Handle) Extend(ctx context.Context) (Response, error) {
 	}
 
 	parsed, meta, err := common.Parse(resp, v4Client.ParsePostVMExtendResponse)
+	meta.QueueWait = wait
 	if err != nil {
-		return Response{ResponseMeta: meta}, err
+		return Response{ResponseMeta: meta}, errutil.InstanceLimit(err, meta.Headers, meta.Raw, clock.From(h.client).Now())
 	}
 
 	return Response{
@@ -161,6 +184,12 @@ func (h *Handle) Extend(ctx context.Context) (Response, error) {
 //	}
 //	fmt.Printf("Terminate result: %s (Success: %t)\n", result.Data.Message, result.Data.Success)
 func (h *Handle) Terminate(ctx context.Context) (Response, error) {
+	unlock, wait, err := service.LockInstance(ctx, h.client)
+	if err != nil {
+		return Response{ResponseMeta: common.ResponseMeta{QueueWait: wait}}, err
+	}
+	defer unlock()
+
 	params := v4Client.PostVMTerminateJSONRequestBody{
 		MachineId: h.id,
 	}
@@ -170,6 +199,7 @@ func (h *Handle) Terminate(ctx context.Context) (Response, error) {
 	}
 
 	parsed, meta, err := common.Parse(req, v4Client.ParsePostVMTerminateResponse)
+	meta.QueueWait = wait
 	if err != nil {
 		return Response{ResponseMeta: meta}, err
 	}
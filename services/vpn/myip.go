@@ -0,0 +1,68 @@
+package vpn
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// ErrNotConnected is returned by MyIP when no VPN connection is active.
+var ErrNotConnected = errors.New("not connected to the VPN")
+
+type MyIPResponse struct {
+	// Data is the address assigned to the tunnel, IPv4 if there is one.
+	Data string
+	// Server is the friendly name of the VPN server, falling back to its
+	// hostname.
+	Server       string
+	ResponseMeta common.ResponseMeta
+}
+
+// MyIP returns the address HTB assigned to the user's VPN tunnel, which is
+// the address targets see connections come from and the one to use for
+// reverse shells. It is read from the connection status, so it changes
+// nothing. ErrNotConnected is returned when no tunnel is up.
+//
+// The API does not report the public address the tunnel was opened from.
+// When several connections are up, such as a lab and a Pro Lab, the first
+// one the API lists is used.
+//
+// Example:
+//
+//	ip, err := client.VPN.MyIP(ctx)
+//	if errors.Is(err, vpn.ErrNotConnected) {
+//		fmt.Println("Start your VPN first")
+//		return
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Tunnel IP %s on %s\n", ip.Data, ip.Server)
+func (s *Service) MyIP(ctx context.Context) (MyIPResponse, error) {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return MyIPResponse{ResponseMeta: status.ResponseMeta}, err
+	}
+
+	for _, conn := range status.Data {
+		ip := conn.Connection.Ip4
+		if ip == "" {
+			ip = conn.Connection.Ip6
+		}
+		if ip == "" {
+			continue
+		}
+		server := conn.Server.FriendlyName
+		if server == "" {
+			server = conn.Server.Hostname
+		}
+		return MyIPResponse{
+			Data:         ip,
+			Server:       server,
+			ResponseMeta: status.ResponseMeta,
+		}, nil
+	}
+
+	return MyIPResponse{ResponseMeta: status.ResponseMeta}, ErrNotConnected
+}
@@ -137,13 +137,8 @@ func (h *Handle) DownloadUDP(ctx context.Context) (VPNFileResponse, error) {
 	}
 
 	return VPNFileResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetAccessOvpnfileVpnIdUDP"),
 	}, nil
 }
 
@@ -171,13 +166,8 @@ func (h *Handle) DownloadTCP(ctx context.Context) (VPNFileResponse, error) {
 	}
 
 	return VPNFileResponse{
-		Data: raw,
-		ResponseMeta: common.ResponseMeta{
-			Raw:        raw,
-			StatusCode: resp.StatusCode,
-			Headers:    resp.Header,
-			CFRay:      resp.Header.Get("CF-Ray"),
-		},
+		Data:         raw,
+		ResponseMeta: common.NewMeta(resp, raw, "GetAccessOvpnfileVpnIdTCP"),
 	}, nil
 }
 
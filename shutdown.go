@@ -0,0 +1,190 @@
+package gohtb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ErrClientClosed is returned for requests issued after Close or Shutdown
+// has been called.
+var ErrClientClosed = errors.New("client closed")
+
+// inflightTracker counts requests that are currently executing so the client
+// can be drained on shutdown. A request is considered in flight from the
+// moment it enters the transport until its response body is closed.
+type inflightTracker struct {
+	mu      sync.Mutex
+	closed  bool
+	active  int
+	drained chan struct{}
+}
+
+func newInflightTracker() *inflightTracker {
+	return &inflightTracker{}
+}
+
+// acquire registers a new request. It returns false once the tracker has been
+// closed, in which case the request must not be sent.
+func (t *inflightTracker) acquire() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return false
+	}
+	t.active++
+	return true
+}
+
+func (t *inflightTracker) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.closed && t.active == 0 && t.drained != nil {
+		close(t.drained)
+		t.drained = nil
+	}
+}
+
+// close stops the tracker from accepting new requests and returns a channel
+// that is closed once every in-flight request has completed.
+func (t *inflightTracker) close() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	if t.active == 0 {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+	if t.drained == nil {
+		t.drained = make(chan struct{})
+	}
+	return t.drained
+}
+
+func (t *inflightTracker) inflight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active
+}
+
+// trackingTransport is the outermost RoundTripper of the client. It rejects
+// requests after Close and keeps the in-flight count up to date.
+type trackingTransport struct {
+	underlying http.RoundTripper
+	tracker    *inflightTracker
+}
+
+func newTrackingTransport(underlying http.RoundTripper, tracker *inflightTracker) *trackingTransport {
+	if underlying == nil {
+		underlying = http.DefaultTransport
+	}
+	return &trackingTransport{underlying: underlying, tracker: tracker}
+}
+
+func (t *trackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.tracker.acquire() {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, ErrClientClosed
+	}
+
+	resp, err := t.underlying.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		t.tracker.release()
+		return resp, err
+	}
+
+	resp.Body = &trackedBody{ReadCloser: resp.Body, release: t.tracker.release}
+	return resp, nil
+}
+
+// CloseIdleConnections forwards to the underlying transport, so
+// http.Client.CloseIdleConnections reaches the connection pool.
+func (t *trackingTransport) CloseIdleConnections() {
+	closeIdleConnections(t.underlying)
+}
+
+func closeIdleConnections(rt http.RoundTripper) {
+	if c, ok := rt.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// trackedBody releases its in-flight slot when the body is closed.
+type trackedBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *trackedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// wrapHTTPClient returns a shallow copy of hc whose transport is tracked.
+// The caller's client is left untouched.
+func wrapHTTPClient(hc *http.Client, tracker *inflightTracker) *http.Client {
+	wrapped := *hc
+	wrapped.Transport = newTrackingTransport(hc.Transport, tracker)
+	return &wrapped
+}
+
+// Close stops the client from accepting new requests and waits for in-flight
+// requests to finish. Requests issued after Close fail with ErrClientClosed.
+//
+// Close blocks until every in-flight request has completed or ctx is done,
+// whichever happens first. It returns the number of requests that were still
+// running when it returned, together with ctx.Err() if the context expired.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	remaining, err := client.Close(ctx)
+//	if err != nil {
+//		log.Printf("shutdown incomplete: %d requests abandoned: %v", remaining, err)
+//	}
+func (c *Client) Close(ctx context.Context) (int, error) {
+	drained := c.inflight.close()
+	select {
+	case <-drained:
+		return 0, nil
+	case <-ctx.Done():
+		return c.inflight.inflight(), ctx.Err()
+	}
+}
+
+// Shutdown is Close for services stopping on a signal. New requests fail
+// with ErrClientClosed at once, before they reach the rate limiter, while
+// requests already running, including downloads whose body is still being
+// read, are left to finish. Once they have, or ctx is done, idle keep-alive
+// connections are closed.
+//
+// If ctx expires first, the returned error wraps ctx.Err() and reports how
+// many requests were still running. Connections those requests use are not
+// interrupted.
+//
+// Example:
+//
+//	<-sigterm
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := client.Shutdown(ctx); err != nil {
+//		log.Printf("shutdown: %v", err)
+//	}
+func (c *Client) Shutdown(ctx context.Context) error {
+	remaining, err := c.Close(ctx)
+	c.httpClient.CloseIdleConnections()
+	if err != nil {
+		return fmt.Errorf("%d requests still in flight: %w", remaining, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package gohtb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingServer answers GetUserInfo only once release is closed. Each
+// request is announced on started when it arrives.
+func blockingServer(t *testing.T) (srv *gohtbtest.Server, started <-chan struct{}, release chan struct{}) {
+	t.Helper()
+	arrived := make(chan struct{}, 16)
+	release = make(chan struct{})
+	srv = gohtbtest.NewServer().HandleFunc("GetUserInfo", func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"info":{"id":1,"name":"alice"}}`)
+	})
+	t.Cleanup(srv.Close)
+	return srv, arrived, release
+}
+
+func shutdownAsync(ctx context.Context, client *gohtb.Client) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- client.Shutdown(ctx) }()
+	return done
+}
+
+func TestShutdownWaitsForInflightRequest(t *testing.T) {
+	srv, started, release := blockingServer(t)
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+
+	call := make(chan error, 1)
+	go func() {
+		_, err := client.Users.Info(context.Background())
+		call <- err
+	}()
+	<-started
+
+	done := shutdownAsync(context.Background(), client)
+	select {
+	case err := <-done:
+		t.Fatalf("Shutdown returned with a request in flight: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-call, "the in-flight request completes")
+	require.NoError(t, <-done)
+}
+
+func TestShutdownRejectsNewRequestsBeforeLimiter(t *testing.T) {
+	srv := gohtbtest.NewServer().JSON("GetUserInfo", `{"info":{"id":1,"name":"alice"}}`)
+	defer srv.Close()
+	// One request's worth of budget on a clock that never moves: a second
+	// request that reached the limiter would wait forever.
+	clk := gohtb.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	client, err := gohtb.New(gohtbtest.Token("1"),
+		gohtb.WithServer(srv.URL),
+		gohtb.WithClock(clk),
+		gohtb.WithRateLimit(1, time.Hour),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Users.Info(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, client.Shutdown(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = client.Users.Info(ctx)
+	assert.ErrorIs(t, err, gohtb.ErrClientClosed)
+	assert.NoError(t, ctx.Err(), "rejected without waiting")
+	assert.Len(t, srv.Requests("GetUserInfo"), 1)
+}
+
+func TestShutdownWaitsForUnreadBody(t *testing.T) {
+	download := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		fmt.Fprint(w, "archive bytes")
+	}))
+	defer download.Close()
+	client, err := gohtb.New(gohtbtest.Token("1"))
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, download.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	done := shutdownAsync(context.Background(), client)
+	select {
+	case err := <-done:
+		t.Fatalf("Shutdown returned with a body unread: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "archive bytes", string(body))
+	select {
+	case err := <-done:
+		t.Fatalf("Shutdown returned before the body was closed: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, <-done)
+}
+
+func TestShutdownContextExpires(t *testing.T) {
+	srv, started, release := blockingServer(t)
+	defer close(release)
+	client, err := gohtb.New(gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+
+	go client.Users.Info(context.Background())
+	go client.Users.Info(context.Background())
+	<-started
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = client.Shutdown(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.EqualError(t, err, "2 requests still in flight: context deadline exceeded")
+}
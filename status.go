@@ -0,0 +1,209 @@
+package gohtb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultStatusURL is the summary feed of the public Hack The Box status
+// page. The API itself has no status endpoint.
+const defaultStatusURL = "https://status.hackthebox.com/api/v2/summary.json"
+
+// StatusState is the health of the platform or one of its components.
+type StatusState string
+
+const (
+	StatusOperational StatusState = "operational"
+	StatusDegraded    StatusState = "degraded"
+	StatusOutage      StatusState = "outage"
+	StatusMaintenance StatusState = "maintenance"
+	// StatusUnknown is used for states the status feed reports that this
+	// version does not recognise.
+	StatusUnknown StatusState = "unknown"
+)
+
+// StatusComponent identifies a part of the platform on the status page.
+type StatusComponent string
+
+const (
+	ComponentAPI      StatusComponent = "api"
+	ComponentPlatform StatusComponent = "platform"
+	ComponentVPN      StatusComponent = "vpn"
+	ComponentPwnbox   StatusComponent = "pwnbox"
+	ComponentAcademy  StatusComponent = "academy"
+	ComponentCTF      StatusComponent = "ctf"
+	// ComponentOther holds every component whose name does not match one of
+	// the known ones. Its original name is kept in ComponentStatus.Name.
+	ComponentOther StatusComponent = "other"
+)
+
+// ComponentStatus is the state of one component on the status page.
+type ComponentStatus struct {
+	Component StatusComponent
+	// Name is the component's name as shown on the status page.
+	Name  string
+	State StatusState
+}
+
+// PlatformStatus is the overall platform state and the state of each
+// component, as reported by the public status page.
+type PlatformStatus struct {
+	State       StatusState
+	Description string
+	Components  []ComponentStatus
+	UpdatedAt   time.Time
+}
+
+type statusSummary struct {
+	Page struct {
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"page"`
+	Status struct {
+		Indicator   string `json:"indicator"`
+		Description string `json:"description"`
+	} `json:"status"`
+	Components []struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Group  bool   `json:"group"`
+	} `json:"components"`
+}
+
+// WithStatusURL overrides the status page feed queried by Client.Status.
+// Defaults to "https://status.hackthebox.com/api/v2/summary.json".
+func WithStatusURL(url string) Option {
+	return func(c *Client) {
+		c.statusURL = url
+	}
+}
+
+// Status reports the platform's overall state and the state of each of its
+// components. The API has no status endpoint, so the public status page's
+// summary feed is queried instead. The request goes through Do, so it is
+// rate limited like API calls but sent without the bearer token.
+//
+// Components are matched to the known StatusComponent values by name;
+// components with any other name are reported as ComponentOther rather than
+// dropped.
+//
+// Example:
+//
+//	status, err := client.Status(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Hack The Box is %s\n", status.State)
+//	for _, c := range status.Components {
+//		if c.State != gohtb.StatusOperational {
+//			fmt.Printf("  %s: %s\n", c.Name, c.State)
+//		}
+//	}
+func (c *Client) Status(ctx context.Context) (PlatformStatus, error) {
+	url := c.statusURL
+	if url == "" {
+		url = defaultStatusURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return PlatformStatus{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return PlatformStatus{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return PlatformStatus{}, fmt.Errorf("status page: unexpected status %s", resp.Status)
+	}
+
+	var summary statusSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		return PlatformStatus{}, fmt.Errorf("status page: %w", err)
+	}
+
+	status := PlatformStatus{
+		State:       indicatorState(summary.Status.Indicator),
+		Description: summary.Status.Description,
+		Components:  make([]ComponentStatus, 0, len(summary.Components)),
+		UpdatedAt:   summary.Page.UpdatedAt,
+	}
+	for _, comp := range summary.Components {
+		if comp.Group {
+			continue
+		}
+		status.Components = append(status.Components, ComponentStatus{
+			Component: componentFor(comp.Name),
+			Name:      comp.Name,
+			State:     componentState(comp.Status),
+		})
+	}
+	return status, nil
+}
+
+// indicatorState maps the status page's overall indicator to a StatusState.
+func indicatorState(indicator string) StatusState {
+	switch indicator {
+	case "none":
+		return StatusOperational
+	case "minor":
+		return StatusDegraded
+	case "major", "critical":
+		return StatusOutage
+	case "maintenance":
+		return StatusMaintenance
+	default:
+		return StatusUnknown
+	}
+}
+
+// componentState maps a status page component state to a StatusState. A
+// partial outage leaves the component usable, so it counts as degraded.
+func componentState(state string) StatusState {
+	switch state {
+	case "operational":
+		return StatusOperational
+	case "degraded_performance", "partial_outage":
+		return StatusDegraded
+	case "major_outage":
+		return StatusOutage
+	case "under_maintenance":
+		return StatusMaintenance
+	default:
+		return StatusUnknown
+	}
+}
+
+// componentKeywords is checked in order, so the more specific names come
+// before the generic platform ones.
+var componentKeywords = []struct {
+	keyword   string
+	component StatusComponent
+}{
+	{"api", ComponentAPI},
+	{"vpn", ComponentVPN},
+	{"pwnbox", ComponentPwnbox},
+	{"academy", ComponentAcademy},
+	{"ctf", ComponentCTF},
+	{"labs", ComponentPlatform},
+	{"platform", ComponentPlatform},
+	{"website", ComponentPlatform},
+}
+
+func componentFor(name string) StatusComponent {
+	lower := strings.ToLower(name)
+	for _, k := range componentKeywords {
+		if strings.Contains(lower, k.keyword) {
+			return k.component
+		}
+	}
+	return ComponentOther
+}
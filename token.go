@@ -0,0 +1,137 @@
+package gohtb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ValidateToken checks that token is accepted by the API and returns the
+// username it belongs to. It is meant for tokens that are not yet stored,
+// such as one pasted by a user, and is independent of any existing Client.
+//
+// A throwaway client is built for the check, configured by options, and
+// closed before returning. Only the current-user endpoint is called, so
+// validating a token has no side effects on the account.
+//
+// ErrInvalidToken is returned if the token is malformed or rejected by the
+// API. A token that lacks the scope for the current-user endpoint is not
+// invalid: that error wraps *ErrInsufficientScope instead. Other failures,
+// such as network errors, are returned as-is so callers do not discard a
+// good token because of a transient problem.
+//
+// Example:
+//
+//	username, err := gohtb.ValidateToken(ctx, pastedToken)
+//	if errors.Is(err, gohtb.ErrInvalidToken) {
+//		fmt.Println("That token was not accepted, please try again")
+//		return
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Token belongs to %s\n", username)
+func ValidateToken(ctx context.Context, token string, options ...Option) (string, error) {
+	if token == "" || isLikelyJWT(token) != nil {
+		return "", ErrInvalidToken
+	}
+
+	c, err := New(token, options...)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close(context.Background())
+
+	info, err := c.Users.Info(ctx)
+	if err != nil {
+		if apiErr, ok := AsAPIError(err); ok && rejectsToken(apiErr) {
+			return "", fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+		return "", err
+	}
+
+	return info.Data.Info.Name, nil
+}
+
+// rejectsToken reports whether apiErr means the token itself was refused:
+// a 401, or a 403 that is not about a missing scope. A token that is valid
+// but lacks a scope is not invalid.
+func rejectsToken(apiErr *APIError) bool {
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusForbidden:
+		var scopeErr *ErrInsufficientScope
+		return !errors.As(apiErr, &scopeErr)
+	}
+	return false
+}
+
+// TokenInfo describes the client's token as read from its JWT claims.
+type TokenInfo struct {
+	// Subject is the ID of the user the token belongs to.
+	Subject string
+	// Scopes lists the scopes the token was granted. "*" means all scopes.
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// HasScope reports whether the token was granted scope, either directly or
+// through the "*" wildcard.
+func (t TokenInfo) HasScope(scope string) bool {
+	return slices.Contains(t.Scopes, "*") || slices.Contains(t.Scopes, scope)
+}
+
+// TokenInfo decodes the scopes and expiry from the client's token. The API
+// has no endpoint describing the current token, so the claims are read
+// locally and no request is made; the signature is not verified.
+//
+// Calls outside the token's scopes fail with an APIError wrapping
+// *ErrInsufficientScope.
+//
+// Example:
+//
+//	info, err := client.TokenInfo()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("Scopes: %v, expires %s\n", info.Scopes, info.ExpiresAt.Format(time.RFC1123))
+func (c *Client) TokenInfo() (TokenInfo, error) {
+	parts := strings.Split(c.htbToken, ".")
+	if len(parts) != 3 {
+		return TokenInfo{}, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return TokenInfo{}, ErrInvalidToken
+	}
+
+	var claims struct {
+		Sub    json.RawMessage `json:"sub"`
+		Scopes []string        `json:"scopes"`
+		Iat    float64         `json:"iat"`
+		Exp    float64         `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return TokenInfo{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	info := TokenInfo{
+		Subject: strings.Trim(string(claims.Sub), `"`),
+		Scopes:  claims.Scopes,
+	}
+	if claims.Iat > 0 {
+		info.IssuedAt = time.Unix(int64(claims.Iat), 0).UTC()
+	}
+	if claims.Exp > 0 {
+		info.ExpiresAt = time.Unix(int64(claims.Exp), 0).UTC()
+	}
+	return info, nil
+}
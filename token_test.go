@@ -0,0 +1,50 @@
+package gohtb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gubarz/gohtb"
+	"github.com/gubarz/gohtb/gohtbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTokenRejections(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantInvalid bool
+		wantScope   bool
+	}{
+		{name: "unauthorized", status: 401, body: `{"message":"Unauthenticated."}`, wantInvalid: true},
+		{name: "forbidden", status: 403, body: `{"message":"This token has been revoked."}`, wantInvalid: true},
+		{name: "missing scope", status: 403, body: `{"message":"Invalid scope(s) provided."}`, wantScope: true},
+		{name: "bad request", status: 400, body: `{"message":"Bad request."}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gohtbtest.NewServer().Status("GetUserInfo", tt.status, tt.body)
+			defer srv.Close()
+
+			_, err := gohtb.ValidateToken(context.Background(), gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+			require.Error(t, err)
+			assert.Equal(t, tt.wantInvalid, errors.Is(err, gohtb.ErrInvalidToken))
+
+			var scopeErr *gohtb.ErrInsufficientScope
+			assert.Equal(t, tt.wantScope, errors.As(err, &scopeErr))
+		})
+	}
+}
+
+func TestValidateTokenReturnsName(t *testing.T) {
+	srv := gohtbtest.NewServer().JSON("GetUserInfo", `{"info":{"id":1,"name":"alice"}}`)
+	defer srv.Close()
+
+	name, err := gohtb.ValidateToken(context.Background(), gohtbtest.Token("1"), gohtb.WithServer(srv.URL))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", name)
+}
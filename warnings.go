@@ -0,0 +1,102 @@
+package gohtb
+
+import (
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gubarz/gohtb/internal/common"
+)
+
+// Warning is a Deprecation, Sunset or Warning header the API sent with a
+// response. Every response's ResponseMeta.Warnings lists them.
+type Warning = common.Warning
+
+// WarningKind identifies the header a Warning was read from.
+type WarningKind = common.WarningKind
+
+const (
+	WarningDeprecation = common.WarningDeprecation
+	WarningSunset      = common.WarningSunset
+	WarningGeneric     = common.WarningGeneric
+)
+
+// Stats are counters the client keeps over its lifetime.
+type Stats struct {
+	// DeprecatedCalls is how many responses marked their endpoint as
+	// deprecated or sunset.
+	DeprecatedCalls uint64
+	// DeprecatedOperations counts those responses by operation ID.
+	DeprecatedOperations map[string]uint64
+}
+
+type clientStats struct {
+	deprecatedCalls atomic.Uint64
+
+	mu            sync.Mutex
+	deprecatedOps map[string]uint64
+}
+
+func newClientStats() *clientStats {
+	return &clientStats{deprecatedOps: make(map[string]uint64)}
+}
+
+func (s *clientStats) recordDeprecated(operation string) {
+	s.deprecatedCalls.Add(1)
+	s.mu.Lock()
+	s.deprecatedOps[operation]++
+	s.mu.Unlock()
+}
+
+// WithOnWarning registers fn to be called with every warning header the API
+// returns, tagged with the operation that returned it. It is called
+// synchronously while the response is parsed, so it should return quickly.
+//
+// Example:
+//
+//	client, err := gohtb.New(token, gohtb.WithOnWarning(func(w gohtb.Warning) {
+//		log.Printf("%s: %s %s", w.Operation, w.Kind, w.Value)
+//	}))
+func WithOnWarning(fn func(Warning)) Option {
+	return func(c *Client) {
+		c.onWarning = fn
+	}
+}
+
+// Stats returns a snapshot of the client's counters. A client created with
+// WithToken shares its parent's counters.
+//
+// Example:
+//
+//	if n := client.Stats().DeprecatedCalls; n > 0 {
+//		log.Fatalf("%d calls hit deprecated endpoints", n)
+//	}
+func (c *Client) Stats() Stats {
+	c.stats.mu.Lock()
+	ops := maps.Clone(c.stats.deprecatedOps)
+	c.stats.mu.Unlock()
+	return Stats{
+		DeprecatedCalls:      c.stats.deprecatedCalls.Load(),
+		DeprecatedOperations: ops,
+	}
+}
+
+// reportWarnings is the client's common.WarningReporter. A response counts
+// once towards DeprecatedCalls however many deprecation headers it has.
+func (c *Client) reportWarnings(operation string, warnings []Warning) {
+	deprecated := false
+	for _, w := range warnings {
+		if w.Deprecated() {
+			deprecated = true
+		}
+		if c.onWarning != nil {
+			c.onWarning(w)
+		}
+		e := Event{Kind: EventWarning, Time: c.clock.Now(), Identity: c.identity, Operation: operation}
+		e.Warning = &w
+		c.events.emit(e)
+	}
+	if deprecated {
+		c.stats.recordDeprecated(operation)
+	}
+}